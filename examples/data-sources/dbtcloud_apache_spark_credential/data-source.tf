@@ -0,0 +1,4 @@
+data "dbtcloud_apache_spark_credential" "example" {
+  project_id    = 123
+  credential_id = 456
+}
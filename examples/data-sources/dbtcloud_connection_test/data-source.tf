@@ -0,0 +1,27 @@
+// test the connection and credential of the production environment once they are created or updated
+data "dbtcloud_connection_test" "prod" {
+  project_id     = dbtcloud_project.my_project.id
+  environment_id = dbtcloud_environment.prod.environment_id
+
+  depends_on = [dbtcloud_snowflake_credential.prod]
+}
+
+// only run the test when requested, e.g. with `terraform plan -var run_connection_tests=true`,
+// report a failed test as a warning and expose its result
+variable "run_connection_tests" {
+  type    = bool
+  default = false
+}
+
+data "dbtcloud_connection_test" "dev" {
+  count = var.run_connection_tests ? 1 : 0
+
+  project_id      = dbtcloud_project.my_project.id
+  environment_id  = dbtcloud_environment.dev.environment_id
+  timeout_seconds = 300
+  fail_on_error   = false
+}
+
+output "dev_connection_test_message" {
+  value = one(data.dbtcloud_connection_test.dev[*].message)
+}
@@ -0,0 +1,18 @@
+data "dbtcloud_dbt_versions" "all" {
+}
+
+// the release tracks, e.g. to validate the dbt_version of environments in a module
+output "release_tracks" {
+  value = [
+    for version in data.dbtcloud_dbt_versions.all.versions : version.version
+    if version.is_release_track
+  ]
+}
+
+// the pinned versions that should be upgraded
+output "deprecated_versions" {
+  value = [
+    for version in data.dbtcloud_dbt_versions.all.versions : "${version.version} (end of life: ${coalesce(version.end_of_life, "unknown")})"
+    if version.is_deprecated
+  ]
+}
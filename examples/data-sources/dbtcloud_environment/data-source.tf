@@ -0,0 +1,21 @@
+// retrieve an environment by ID
+data "dbtcloud_environment" "my_env" {
+  project_id     = dbtcloud_project.my_project.id
+  environment_id = 1234
+}
+
+// or by name, the name must be unique in the project
+data "dbtcloud_environment" "my_env_by_name" {
+  project_id = dbtcloud_project.my_project.id
+  name       = "Production"
+}
+
+// or by deployment type, a project has at most one production and one staging environment
+data "dbtcloud_environment" "production" {
+  project_id      = dbtcloud_project.my_project.id
+  deployment_type = "production"
+}
+
+output "production_adapter" {
+  value = data.dbtcloud_environment.production.connection_adapter_version
+}
@@ -0,0 +1,16 @@
+// all the environment variables of a project, with their values for each environment
+data "dbtcloud_environment_variables" "all" {
+  project_id = dbtcloud_project.my_project.id
+}
+
+// including the overrides of a specific job
+data "dbtcloud_environment_variables" "with_job_overrides" {
+  project_id = dbtcloud_project.my_project.id
+  job_id     = dbtcloud_job.my_job.id
+}
+
+// including the overrides of all the jobs of an environment
+data "dbtcloud_environment_variables" "with_environment_overrides" {
+  project_id     = dbtcloud_project.my_project.id
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+}
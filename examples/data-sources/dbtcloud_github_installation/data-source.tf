@@ -0,0 +1,3 @@
+data "dbtcloud_github_installation" "my_org" {
+  organization = "my-org"
+}
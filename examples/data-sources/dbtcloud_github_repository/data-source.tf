@@ -0,0 +1,10 @@
+data "dbtcloud_github_repository" "my_repo" {
+  full_name = "my-org/my-repo"
+}
+
+resource "dbtcloud_repository" "github_repo" {
+  project_id             = dbtcloud_project.dbt_project.id
+  remote_url             = data.dbtcloud_github_repository.my_repo.ssh_url
+  github_installation_id = data.dbtcloud_github_repository.my_repo.github_installation_id
+  git_clone_strategy     = "github_app"
+}
@@ -0,0 +1,10 @@
+data "dbtcloud_gitlab_project" "my_project" {
+  path_with_namespace = "my-group/my-project"
+}
+
+resource "dbtcloud_repository" "gitlab_repo" {
+  project_id         = dbtcloud_project.dbt_project.id
+  remote_url         = data.dbtcloud_gitlab_project.my_project.path_with_namespace
+  gitlab_project_id  = data.dbtcloud_gitlab_project.my_project.id
+  git_clone_strategy = "deploy_token"
+}
@@ -0,0 +1,18 @@
+// retrieve a job by ID
+data "dbtcloud_job" "my_job" {
+  job_id = 12345
+}
+
+// or by name, the name must be unique in the environment
+data "dbtcloud_job" "daily_job" {
+  project_id     = dbtcloud_project.my_project.id
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  name           = "Daily job"
+
+  include_latest_runs = true
+}
+
+// the latest successful run can be used for example to monitor the freshness of the data
+output "daily_job_last_success" {
+  value = data.dbtcloud_job.daily_job.latest_successful_run.finished_at
+}
@@ -0,0 +1,16 @@
+// we can build the graph for some projects
+data dbtcloud_job_graph my_projects {
+  project_ids = [1234, 5678]
+}
+
+// or for all the projects of the account
+data dbtcloud_job_graph all_projects {
+}
+
+// the graph can then be used to make sure that jobs don't keep triggering each other
+check "no_job_completion_trigger_cycles" {
+  assert {
+    condition     = !data.dbtcloud_job_graph.all_projects.has_cycles
+    error_message = "Some jobs are triggering each other in a loop: ${jsonencode(data.dbtcloud_job_graph.all_projects.cycles)}"
+  }
+}
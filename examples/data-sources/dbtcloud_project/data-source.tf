@@ -38,3 +38,9 @@ output "filtered_projects" {
 output "project_names" {
   value = [for project in data.dbtcloud_projects.filtered_projects.projects : project.name]
 }
+
+
+// the environments of the project are returned as well, e.g. to find the production one
+output "production_environment_id" {
+  value = one([for env in data.dbtcloud_project.project_by_name.environments : env.id if env.deployment_type == "production"])
+}
@@ -0,0 +1,15 @@
+// the upstream dependencies of a single project
+data "dbtcloud_project_dependencies" "marketing" {
+  project_id = dbtcloud_project.marketing.id
+}
+
+// the dependency graph across all the projects of the account
+data "dbtcloud_project_dependencies" "all" {
+}
+
+output "projects_depending_on_platform" {
+  value = [
+    for dependency in data.dbtcloud_project_dependencies.all.dependencies : dependency.project_id
+    if dependency.upstream_project_id == dbtcloud_project.platform.id
+  ]
+}
@@ -0,0 +1,18 @@
+// all the repositories of the account
+data "dbtcloud_repositories" "all" {
+}
+
+// the repositories of a given project
+data "dbtcloud_repositories" "my_project" {
+  project_id = dbtcloud_project.my_project.id
+}
+
+// the repositories still cloned with a deploy key instead of the GitHub App
+data "dbtcloud_repositories" "github_deploy_key" {
+  git_clone_strategy  = "deploy_key"
+  remote_url_contains = "github.com"
+}
+
+output "projects_to_migrate_to_github_app" {
+  value = distinct([for repo in data.dbtcloud_repositories.github_deploy_key.repositories : repo.project_id])
+}
@@ -0,0 +1,9 @@
+data "dbtcloud_ssh_tunnels" "all" {}
+
+# the public keys to add to the bastion servers, by connection ID
+output "ssh_public_keys" {
+  value = {
+    for tunnel in data.dbtcloud_ssh_tunnels.all.ssh_tunnels :
+    tunnel.connection_id => tunnel.public_key
+  }
+}
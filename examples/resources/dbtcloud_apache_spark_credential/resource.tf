@@ -0,0 +1,19 @@
+resource "dbtcloud_apache_spark_credential" "example" {
+  project_id = dbtcloud_project.example.id
+  token      = var.spark_token
+  schema     = "my_schema"
+  // optional fields
+  cluster     = "my-other-cluster"
+  target_name = "spark"
+  threads     = 8
+}
+
+resource "dbtcloud_environment" "prod" {
+  project_id      = dbtcloud_project.example.id
+  name            = "Production"
+  type            = "deployment"
+  deployment_type = "production"
+  dbt_version     = "latest"
+  connection_id   = dbtcloud_global_connection.apache_spark.id
+  credential_id   = dbtcloud_apache_spark_credential.example.credential_id
+}
@@ -0,0 +1,22 @@
+resource "dbtcloud_credential" "teradata" {
+  project_id      = dbtcloud_project.example.id
+  adapter_version = "teradata_v0"
+  fields = {
+    user    = "my_user"
+    schema  = "my_schema"
+    threads = "8"
+  }
+  sensitive_fields = {
+    password = var.teradata_password
+  }
+}
+
+resource "dbtcloud_environment" "prod" {
+  project_id      = dbtcloud_project.example.id
+  name            = "Production"
+  type            = "deployment"
+  deployment_type = "production"
+  dbt_version     = "latest"
+  connection_id   = dbtcloud_global_connection.teradata.id
+  credential_id   = dbtcloud_credential.teradata.credential_id
+}
@@ -0,0 +1,17 @@
+// create a staging environment from the production one, with copies of its jobs
+resource "dbtcloud_environment_clone" "staging" {
+  project_id            = dbtcloud_project.dbt_project.id
+  source_environment_id = dbtcloud_environment.prod_environment.environment_id
+  name                  = "Staging"
+  deployment_type       = "staging"
+  credential_id         = dbtcloud_snowflake_credential.staging_credential.credential_id
+
+  copy_extended_attributes   = true
+  copy_environment_variables = true
+  copy_jobs                  = true
+}
+
+// the IDs of the copied jobs can be retrieved from the IDs of the source jobs
+output "staging_daily_job_id" {
+  value = dbtcloud_environment_clone.staging.job_ids[tostring(dbtcloud_job.daily_job.id)]
+}
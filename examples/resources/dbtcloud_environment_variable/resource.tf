@@ -13,4 +13,18 @@ resource "dbtcloud_environment_variable" "dbt_my_env_var" {
     dbtcloud_environment.ci_env,
     dbtcloud_environment.prod_env,
   ]
-}
\ No newline at end of file
+}
+
+// secret environment variables can be set with write-only values (requires Terraform 1.11 or later)
+// the values are never stored in the state, only a salted hash to detect changes
+resource "dbtcloud_environment_variable" "dbt_my_secret_env_var" {
+  name       = "DBT_ENV_SECRET_MY_TOKEN"
+  project_id = dbtcloud_project.dbt_project.id
+  secret_environment_values = {
+    "project" : var.my_project_level_token,
+    "Prod" : var.my_prod_token
+  }
+  depends_on = [
+    dbtcloud_environment.prod_env,
+  ]
+}
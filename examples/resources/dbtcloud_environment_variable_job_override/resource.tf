@@ -3,4 +3,12 @@ resource "dbtcloud_environment_variable_job_override" "my_env_var_job_override"
   project_id        = dbtcloud_project.dbt_project.id
   job_definition_id = dbtcloud_job.daily_job.id
   raw_value         = "my_override_value"
-}
\ No newline at end of file
+}
+
+// overrides of secret environment variables can be set with a write-only value (requires Terraform 1.11 or later)
+resource "dbtcloud_environment_variable_job_override" "my_secret_env_var_job_override" {
+  name              = dbtcloud_environment_variable.dbt_my_secret_env_var.name
+  project_id        = dbtcloud_project.dbt_project.id
+  job_definition_id = dbtcloud_job.daily_job.id
+  secret_raw_value  = var.my_job_token
+}
@@ -0,0 +1,27 @@
+// all the environment variables of the project are managed by this resource
+// any variable not listed here is deleted from the project
+// when there are some, creating the resource fails unless it is imported first or allow_deleting_unmanaged is set
+resource "dbtcloud_environment_variables" "all_env_vars" {
+  project_id = dbtcloud_project.dbt_project.id
+  variables = {
+    "DBT_MY_ENV_VAR" = {
+      "project" : "my_project_level_value",
+      "Dev" : "my_env_level_value",
+      "Prod" : "my_prod_override_value"
+    }
+    "DBT_ANOTHER_ENV_VAR" = {
+      "project" : "another_value"
+    }
+  }
+  // secret values are write-only, only a hash of them is stored in the state
+  secret_variables = {
+    "DBT_ENV_SECRET_TOKEN" = {
+      "project" : var.dbt_token,
+      "Prod" : var.dbt_prod_token
+    }
+  }
+  depends_on = [
+    dbtcloud_environment.dev_env,
+    dbtcloud_environment.prod_env,
+  ]
+}
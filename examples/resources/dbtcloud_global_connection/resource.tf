@@ -38,6 +38,25 @@ resource "dbtcloud_global_connection" "bigquery" {
   }
 }
 
+// the bigquery_v1 adapter is required for BigQuery Workload Identity Federation
+// changing adapter_version from bigquery_v0 to bigquery_v1 upgrades an existing connection in place
+resource "dbtcloud_global_connection" "bigquery_v1" {
+  name            = "My BigQuery connection with the latest adapter"
+  adapter_version = "bigquery_v1"
+  bigquery = {
+    gcp_project_id                = "my-gcp-project-id"
+    job_execution_timeout_seconds = 1000
+    private_key_id                = "my-private-key-id"
+    private_key                   = "ABCDEFGHIJKL"
+    client_email                  = "my_client_email"
+    client_id                     = "my_client_id"
+    auth_uri                      = "my_auth_uri"
+    token_uri                     = "my_token_uri"
+    auth_provider_x509_cert_url   = "my_auth_provider_x509_cert_url"
+    client_x509_cert_url          = "my_client_x509_cert_url"
+  }
+}
+
 resource "dbtcloud_global_connection" "databricks" {
   name = "My Databricks connection"
   databricks = {
@@ -131,4 +150,19 @@ resource "dbtcloud_global_connection" "teradata" {
     request_timeout = 600
     retries = 3
   }
-}
\ No newline at end of file
+}
+// adapters without a dedicated block can be configured with the config sent as is to dbt Cloud
+resource "dbtcloud_global_connection" "clickhouse" {
+  name = "My ClickHouse connection"
+  custom = {
+    adapter_version = "clickhouse_v0"
+    config = jsonencode({
+      host   = "my-clickhouse-server.com"
+      port   = 8443
+      secure = true
+    })
+    sensitive_config = jsonencode({
+      password = var.clickhouse_password
+    })
+  }
+}
@@ -102,4 +102,51 @@ resource "dbtcloud_job" "daily_job" {
   schedule_type  = "interval_cron"
   schedule_days = [0, 1, 2, 3, 4, 5, 6]
   schedule_interval = 5
-}
\ No newline at end of file
+}
+# a job overriding some environment variables
+# all the overrides of the job are managed here, so dbtcloud_environment_variable_job_override should not be used for this job
+resource "dbtcloud_job" "full_refresh_job" {
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  execute_steps = [
+    "dbt build --full-refresh"
+  ]
+  name       = "Full refresh job"
+  project_id = dbtcloud_project.dbt_project.id
+  triggers = {
+    "github_webhook" : false
+    "git_provider_webhook" : false
+    "schedule" : false
+    "on_merge" : false
+  }
+
+  env_var_overrides = {
+    DBT_FULL_REFRESH_BATCH_SIZE = "1000000"
+    DBT_TARGET_SCHEMA_SUFFIX    = "_full_refresh"
+  }
+
+  depends_on = [
+    dbtcloud_environment_variable.batch_size,
+    dbtcloud_environment_variable.target_schema_suffix,
+  ]
+}
+
+# a deploy job using state-aware orchestration, only running the models with changes since the last run
+resource "dbtcloud_job" "state_aware_job" {
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  execute_steps = [
+    "dbt build"
+  ]
+  name       = "State-aware job"
+  project_id = dbtcloud_project.dbt_project.id
+  triggers = {
+    "github_webhook" : false
+    "git_provider_webhook" : false
+    "schedule" : true
+    "on_merge" : false
+  }
+  schedule_type  = "every_day"
+  schedule_hours = [6]
+
+  force_node_selection       = false
+  cost_optimization_features = ["state_aware_orchestration"]
+}
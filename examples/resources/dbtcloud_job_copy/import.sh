@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_job_copy.my_job_copy
+  id = "source_job_id:job_id"
+}
+
+import {
+  to = dbtcloud_job_copy.my_job_copy
+  id = "12345:67890"
+}
+
+# using the older import command
+terraform import dbtcloud_job_copy.my_job_copy "source_job_id:job_id"
+terraform import dbtcloud_job_copy.my_job_copy 12345:67890
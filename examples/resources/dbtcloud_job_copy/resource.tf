@@ -0,0 +1,18 @@
+// copy the daily job of the production environment into the staging environment
+// only the name and the number of threads are different from the source job
+resource "dbtcloud_job_copy" "daily_job_staging" {
+  source_job_id  = dbtcloud_job.daily_job.id
+  environment_id = dbtcloud_environment.staging_environment.environment_id
+  name           = "Daily job - Staging"
+  num_threads    = 16
+}
+
+// copy a job and keep it in sync with the source job
+// any change made to the source job (except for the overridden fields) is applied to the copy on the next apply
+resource "dbtcloud_job_copy" "ci_job_other_project" {
+  source_job_id    = dbtcloud_job.ci_job.id
+  project_id       = dbtcloud_project.other_project.id
+  environment_id   = dbtcloud_environment.other_ci_environment.environment_id
+  execute_steps    = ["dbt build -s state:modified+ --fail-fast"]
+  sync_with_source = true
+}
@@ -0,0 +1,8 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_privatelink_endpoint.snowflake
+  id = "endpoint_id"
+}
+
+# using the older import command
+terraform import dbtcloud_privatelink_endpoint.snowflake "endpoint_id"
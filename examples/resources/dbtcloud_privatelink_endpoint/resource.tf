@@ -0,0 +1,20 @@
+// the VPC endpoint service exposing Snowflake, as provided by AWS PrivateLink for Snowflake
+resource "dbtcloud_privatelink_endpoint" "snowflake" {
+  name         = "Snowflake production"
+  type         = "snowflake"
+  service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0"
+
+  // optional, the endpoint is provisioned by dbt Cloud and this can take a while
+  timeout_minutes = 120
+}
+
+resource "dbtcloud_global_connection" "snowflake" {
+  name                     = "Snowflake via PrivateLink"
+  private_link_endpoint_id = dbtcloud_privatelink_endpoint.snowflake.id
+
+  snowflake = {
+    account   = "my-snowflake-account"
+    database  = "MY_DATABASE"
+    warehouse = "MY_WAREHOUSE"
+  }
+}
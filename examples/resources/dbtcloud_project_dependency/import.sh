@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_project_dependency.my_dependency
+  id = "project_id:upstream_project_id"
+}
+
+import {
+  to = dbtcloud_project_dependency.my_dependency
+  id = "12345:6789"
+}
+
+# using the older import command
+terraform import dbtcloud_project_dependency.my_dependency "project_id:upstream_project_id"
+terraform import dbtcloud_project_dependency.my_dependency 12345:6789
@@ -0,0 +1,16 @@
+// the upstream project needs a production environment for its public models to be referenced
+resource "dbtcloud_environment" "platform_prod" {
+  project_id      = dbtcloud_project.platform.id
+  name            = "Production"
+  type            = "deployment"
+  deployment_type = "production"
+  credential_id   = dbtcloud_snowflake_credential.platform_prod.credential_id
+  connection_id   = dbtcloud_global_connection.snowflake.id
+}
+
+resource "dbtcloud_project_dependency" "marketing_on_platform" {
+  project_id          = dbtcloud_project.marketing.id
+  upstream_project_id = dbtcloud_project.platform.id
+
+  depends_on = [dbtcloud_environment.platform_prod]
+}
@@ -9,23 +9,15 @@ resource "dbtcloud_repository" "github_repo" {
 
 
 ### repo cloned via the GitHub integration, with auto-retrieval of the `github_installation_id`
-# here, we assume that `token` and `host_url` are respectively accessible via `var.dbt_token` and `var.dbt_host_url`
 # NOTE: the following requires connecting via a user token and can't be retrieved with a service token
-data "http" "github_installations_response" {
-  url = format("%s/v2/integrations/github/installations/", var.dbt_host_url)
-  request_headers = {
-    Authorization = format("Bearer %s", var.dbt_token)
-  }
-}
-
-locals {
-  github_installation_id = jsondecode(data.http.github_installations_response.response_body)[0].id
+data "dbtcloud_github_installation" "my_org" {
+  organization = "<github_org>"
 }
 
 resource "dbtcloud_repository" "github_repo_other" {
   project_id             = dbtcloud_project.dbt_project.id
   remote_url             = "git@github.com:<github_org>/<github_repo>.git"
-  github_installation_id = local.github_installation_id
+  github_installation_id = data.dbtcloud_github_installation.my_org.id
   git_clone_strategy     = "github_app"
 }
 
@@ -69,4 +61,25 @@ resource "dbtcloud_repository" "ado_repo" {
   remote_url = "git@github.somecorp.com:username/terraform-provider.git"
   private_link_endpoint_id = "<private_link_endpoint_id>"
   pull_request_url_template = "https://github.somecorp.com/username/terraform-provider/revert2/{{destination}}...{{source}}"
-}
\ No newline at end of file
+}
+
+### repo cloned via the deploy key strategy, with a deploy key rotated every 90 days
+resource "time_rotating" "deploy_key" {
+  rotation_days = 90
+}
+
+resource "dbtcloud_repository" "rotated_deploy_key_repo" {
+  project_id         = dbtcloud_project.dbt_project.id
+  remote_url         = "git@github.com:<github_org>/<github_repo>.git"
+  git_clone_strategy = "deploy_key"
+  # changing the value regenerates the deploy key without recreating the repository
+  rotation_trigger = time_rotating.deploy_key.id
+}
+
+# the new public key is added to GitHub in the same apply
+resource "github_repository_deploy_key" "dbt_cloud" {
+  title      = "dbt Cloud"
+  repository = "<github_repo>"
+  key        = dbtcloud_repository.rotated_deploy_key_repo.deploy_key
+  read_only  = false
+}
@@ -0,0 +1,40 @@
+resource "dbtcloud_snowflake_credential" "prod" {
+  project_id  = dbtcloud_project.dbt_project.id
+  auth_type   = "keypair"
+  user        = "DBT_PROD"
+  schema      = "ANALYTICS"
+  num_threads = 16
+  # private_key and private_key_passphrase are managed by dbtcloud_snowflake_credential_key_rotation
+}
+
+resource "tls_private_key" "slot_1" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_private_key" "slot_2" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+# to rotate the key:
+# 1. stage a new key in the slot not in use, e.g. by replacing tls_private_key.slot_2, and apply
+# 2. set its public key in RSA_PUBLIC_KEY_2 for the Snowflake user
+# 3. switch active_key_slot to 2 and apply, the credential now uses the new key
+# 4. unset RSA_PUBLIC_KEY for the Snowflake user, the slot 1 is then used for the next rotation
+resource "dbtcloud_snowflake_credential_key_rotation" "prod" {
+  project_id      = dbtcloud_project.dbt_project.id
+  credential_id   = dbtcloud_snowflake_credential.prod.credential_id
+  active_key_slot = 1
+  private_key_1   = tls_private_key.slot_1.private_key_pem_pkcs8
+  private_key_2   = tls_private_key.slot_2.private_key_pem_pkcs8
+}
+
+# the public keys to set in Snowflake, e.g. ALTER USER DBT_PROD SET RSA_PUBLIC_KEY_2 = '...'
+output "snowflake_rsa_public_key" {
+  value = dbtcloud_snowflake_credential_key_rotation.prod.public_key_1
+}
+
+output "snowflake_rsa_public_key_2" {
+  value = dbtcloud_snowflake_credential_key_rotation.prod.public_key_2
+}
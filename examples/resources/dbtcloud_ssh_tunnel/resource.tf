@@ -0,0 +1,28 @@
+resource "dbtcloud_global_connection" "redshift" {
+  name = "Redshift via SSH tunnel"
+
+  # the ssh_tunnel block is not set when the SSH tunnel is managed with dbtcloud_ssh_tunnel
+  redshift = {
+    hostname = "my-cluster.abc123.us-east-1.redshift.amazonaws.com"
+    port     = 5439
+    dbname   = "analytics"
+  }
+}
+
+# regenerate the key pair every 90 days
+resource "time_rotating" "ssh_key" {
+  rotation_days = 90
+}
+
+resource "dbtcloud_ssh_tunnel" "redshift" {
+  connection_id    = dbtcloud_global_connection.redshift.id
+  username         = "dbt"
+  hostname         = "bastion.example.com"
+  port             = 22
+  rotation_trigger = time_rotating.ssh_key.id
+}
+
+# the public key can then be added to the authorized_keys of the bastion server
+output "ssh_public_key" {
+  value = dbtcloud_ssh_tunnel.redshift.public_key
+}
@@ -0,0 +1,326 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// AdapterCredential is a credential for any adapter, its fields are described by the metadata of the adapter version
+type AdapterCredential struct {
+	ID                *int                     `json:"id,omitempty"`
+	AccountID         int                      `json:"account_id"`
+	ProjectID         int                      `json:"project_id"`
+	Type              string                   `json:"type"`
+	State             int                      `json:"state"`
+	Threads           int                      `json:"threads,omitempty"`
+	TargetName        string                   `json:"target_name,omitempty"`
+	AdapterVersion    string                   `json:"adapter_version"`
+	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
+}
+
+type AdapterCredentialResponse struct {
+	Data   AdapterCredential `json:"data"`
+	Status ResponseStatus    `json:"status"`
+}
+
+type AdapterVersionCredentialFields struct {
+	AdapterVersion    string                   `json:"adapter_version"`
+	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
+}
+
+type AdapterVersionCredentialFieldsResponse struct {
+	Data   AdapterVersionCredentialFields `json:"data"`
+	Status ResponseStatus                 `json:"status"`
+}
+
+// GetAdapterCredentialFields returns the credential fields of an adapter version, with their metadata and default values
+func (c *Client) GetAdapterCredentialFields(adapterVersion string) (*AdapterCredentialDetails, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/adapters/versions/%s/credential-fields/",
+			c.HostURL,
+			c.AccountID,
+			adapterVersion,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsResponse := AdapterVersionCredentialFieldsResponse{}
+	err = json.Unmarshal(body, &fieldsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldsResponse.Data.CredentialDetails, nil
+}
+
+func (c *Client) GetAdapterCredential(projectID int, credentialID int) (*AdapterCredential, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+			credentialID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := AdapterCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func (c *Client) CreateAdapterCredential(
+	projectID int,
+	adapterVersion string,
+	credentialDetails AdapterCredentialDetails,
+) (*AdapterCredential, error) {
+	newCredential := AdapterCredential{
+		AccountID:         c.AccountID,
+		ProjectID:         projectID,
+		Type:              "adapter",
+		State:             STATE_ACTIVE,
+		Threads:           credentialDetails.threads(),
+		TargetName:        DEFAULT_TARGET_NAME,
+		AdapterVersion:    adapterVersion,
+		CredentialDetails: credentialDetails,
+	}
+	newCredentialData, err := json.Marshal(newCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+		),
+		strings.NewReader(string(newCredentialData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := AdapterCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func (c *Client) UpdateAdapterCredential(
+	projectID int,
+	credentialID int,
+	adapterVersion string,
+	credentialDetails AdapterCredentialDetails,
+) (*AdapterCredential, error) {
+	updatedCredential := AdapterCredential{
+		ID:                &credentialID,
+		AccountID:         c.AccountID,
+		ProjectID:         projectID,
+		Type:              "adapter",
+		State:             STATE_ACTIVE,
+		Threads:           credentialDetails.threads(),
+		TargetName:        DEFAULT_TARGET_NAME,
+		AdapterVersion:    adapterVersion,
+		CredentialDetails: credentialDetails,
+	}
+	updatedCredentialData, err := json.Marshal(updatedCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+			credentialID,
+		),
+		strings.NewReader(string(updatedCredentialData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := AdapterCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+// threads returns the value of the threads field, which is also sent at the top level of the credential.
+// The value is an int when generated from the config but a float64 when it comes from the JSON of the API.
+func (d AdapterCredentialDetails) threads() int {
+	switch threads := d.Fields["threads"].Value.(type) {
+	case int:
+		return threads
+	case int64:
+		return int(threads)
+	case float64:
+		return int(threads)
+	case string:
+		value, _ := strconv.Atoi(threads)
+		return value
+	default:
+		return 0
+	}
+}
+
+// GenerateAdapterCredentialDetails fills the credential fields of an adapter version with the values provided.
+// Values are given as strings and converted based on the field type, encrypted fields must be provided in
+// sensitiveFields and the other ones in fields. Fields not provided keep the default value from the metadata.
+// All the validation errors are returned together.
+func GenerateAdapterCredentialDetails(
+	adapterFields AdapterCredentialDetails,
+	fields map[string]string,
+	sensitiveFields map[string]string,
+) (AdapterCredentialDetails, error) {
+	errs := []error{}
+
+	for _, key := range slices.Sorted(maps.Keys(sensitiveFields)) {
+		if _, ok := fields[key]; ok {
+			errs = append(errs, fmt.Errorf("the field %q is set in both fields and sensitive_fields", key))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(lo.Assign(fields, sensitiveFields))) {
+		if _, ok := adapterFields.Fields[key]; !ok {
+			errs = append(
+				errs,
+				fmt.Errorf(
+					"the field %q is not a credential field of the adapter, valid fields are: %s",
+					key,
+					strings.Join(slices.Sorted(maps.Keys(adapterFields.Fields)), ", "),
+				),
+			)
+		}
+	}
+
+	credentialFields := map[string]AdapterCredentialField{}
+	for _, key := range slices.Sorted(maps.Keys(adapterFields.Fields)) {
+		field := adapterFields.Fields[key]
+
+		value, isSet := fields[key]
+		sensitiveValue, isSensitiveSet := sensitiveFields[key]
+
+		switch {
+		case field.Metadata.Encrypt && isSet:
+			errs = append(errs, fmt.Errorf("the field %q is encrypted and must be set in sensitive_fields", key))
+		case !field.Metadata.Encrypt && isSensitiveSet:
+			errs = append(errs, fmt.Errorf("the field %q is not encrypted and must be set in fields", key))
+		}
+
+		if isSensitiveSet {
+			value, isSet = sensitiveValue, true
+		}
+
+		if !isSet {
+			if field.Metadata.Validation.Required && (field.Value == nil || field.Value == "") {
+				errs = append(errs, fmt.Errorf("the field %q is required", key))
+			}
+			credentialFields[key] = field
+			continue
+		}
+
+		if len(field.Metadata.Options) > 0 {
+			options := lo.Map(
+				field.Metadata.Options,
+				func(option AdapterCredentialFieldMetadataOptions, _ int) string { return option.Value },
+			)
+			if !slices.Contains(options, value) {
+				errs = append(
+					errs,
+					fmt.Errorf("the field %q must be one of: %s, got %q", key, strings.Join(options, ", "), value),
+				)
+			}
+		}
+
+		typedValue, err := adapterFieldValue(field.Metadata.Field_Type, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("the field %q: %w", key, err))
+		}
+
+		field.Value = typedValue
+		credentialFields[key] = field
+	}
+
+	if len(errs) > 0 {
+		return AdapterCredentialDetails{}, errors.Join(errs...)
+	}
+
+	return AdapterCredentialDetails{
+		Fields:      credentialFields,
+		Field_Order: adapterFields.Field_Order,
+	}, nil
+}
+
+func adapterFieldValue(fieldType string, value string) (any, error) {
+	switch fieldType {
+	case "number":
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number, got %q", value)
+		}
+		return intValue, nil
+	case "boolean":
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected a boolean, got %q", value)
+		}
+		return boolValue, nil
+	default:
+		return value, nil
+	}
+}
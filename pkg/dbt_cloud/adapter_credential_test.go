@@ -0,0 +1,120 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAdapterCredentialFields() dbt_cloud.AdapterCredentialDetails {
+	return dbt_cloud.AdapterCredentialDetails{
+		Fields: map[string]dbt_cloud.AdapterCredentialField{
+			"auth_type": {
+				Metadata: dbt_cloud.AdapterCredentialFieldMetadata{Field_Type: "hidden"},
+				Value:    "token",
+			},
+			"token": {
+				Metadata: dbt_cloud.AdapterCredentialFieldMetadata{
+					Field_Type: "text",
+					Encrypt:    true,
+					Validation: dbt_cloud.AdapterCredentialFieldMetadataValidation{Required: true},
+				},
+			},
+			"schema": {
+				Metadata: dbt_cloud.AdapterCredentialFieldMetadata{
+					Field_Type: "text",
+					Validation: dbt_cloud.AdapterCredentialFieldMetadataValidation{Required: true},
+				},
+			},
+			"threads": {
+				Metadata: dbt_cloud.AdapterCredentialFieldMetadata{Field_Type: "number"},
+				Value:    4,
+			},
+			"method": {
+				Metadata: dbt_cloud.AdapterCredentialFieldMetadata{
+					Field_Type: "select",
+					Options: []dbt_cloud.AdapterCredentialFieldMetadataOptions{
+						{Label: "HTTP", Value: "http"},
+						{Label: "Thrift", Value: "thrift"},
+					},
+				},
+				Value: "http",
+			},
+		},
+		Field_Order: []string{"auth_type", "token", "schema", "threads", "method"},
+	}
+}
+
+func TestGenerateAdapterCredentialDetails(t *testing.T) {
+	details, err := dbt_cloud.GenerateAdapterCredentialDetails(
+		testAdapterCredentialFields(),
+		map[string]string{"schema": "analytics", "threads": "8"},
+		map[string]string{"token": "secret"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "analytics", details.Fields["schema"].Value)
+	assert.Equal(t, 8, details.Fields["threads"].Value)
+	assert.Equal(t, "secret", details.Fields["token"].Value)
+	// the fields not set keep their default value
+	assert.Equal(t, "token", details.Fields["auth_type"].Value)
+	assert.Equal(t, "http", details.Fields["method"].Value)
+	assert.Equal(t, []string{"auth_type", "token", "schema", "threads", "method"}, details.Field_Order)
+}
+
+func TestGenerateAdapterCredentialDetails_Validation(t *testing.T) {
+	_, err := dbt_cloud.GenerateAdapterCredentialDetails(
+		testAdapterCredentialFields(),
+		map[string]string{"token": "secret", "threads": "many", "method": "odbc", "catalog": "main"},
+		map[string]string{"token": "secret"},
+	)
+	assert.EqualError(t, err, `the field "token" is set in both fields and sensitive_fields
+the field "catalog" is not a credential field of the adapter, valid fields are: auth_type, method, schema, threads, token
+the field "method" must be one of: http, thrift, got "odbc"
+the field "schema" is required
+the field "threads": expected a number, got "many"
+the field "token" is encrypted and must be set in sensitive_fields`)
+
+	_, err = dbt_cloud.GenerateAdapterCredentialDetails(
+		testAdapterCredentialFields(),
+		map[string]string{},
+		map[string]string{"schema": "analytics"},
+	)
+	assert.EqualError(t, err, `the field "schema" is not encrypted and must be set in fields
+the field "token" is required`)
+}
+
+func TestCreateAdapterCredential_Threads(t *testing.T) {
+	sentThreads := []int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		credential := dbt_cloud.AdapterCredential{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&credential))
+		sentThreads = append(sentThreads, credential.Threads)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": credential})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	// threads set from the config are ints
+	details := testAdapterCredentialFields()
+	_, err := client.CreateAdapterCredential(10, "databricks_v0", details)
+	assert.NoError(t, err)
+
+	// threads read from the API are decoded as float64
+	var decoded dbt_cloud.AdapterCredentialDetails
+	data, err := json.Marshal(details)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.IsType(t, float64(0), decoded.Fields["threads"].Value)
+	_, err = client.CreateAdapterCredential(10, "databricks_v0", decoded)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{4, 4}, sentThreads)
+}
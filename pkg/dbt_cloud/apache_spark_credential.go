@@ -0,0 +1,244 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ApacheSparkCredentialResponse struct {
+	Data   ApacheSparkCredentialData `json:"data"`
+	Status ResponseStatus            `json:"status"`
+}
+
+type ApacheSparkUnencryptedCredentialDetails struct {
+	Schema     string `json:"schema"`
+	Cluster    string `json:"cluster"`
+	TargetName string `json:"target_name"`
+	Threads    int    `json:"threads"`
+}
+
+// ApacheSparkCredentialData represents the data returned by the API for an Apache Spark credential
+type ApacheSparkCredentialData struct {
+	ID                           *int                                    `json:"id"`
+	AccountID                    int                                     `json:"account_id"`
+	ProjectID                    int                                     `json:"project_id"`
+	Type                         string                                  `json:"type"`
+	State                        int                                     `json:"state"`
+	Threads                      int                                     `json:"threads"`
+	TargetName                   string                                  `json:"target_name"`
+	AdapterVersion               string                                  `json:"adapter_version,omitempty"`
+	UnencryptedCredentialDetails ApacheSparkUnencryptedCredentialDetails `json:"unencrypted_credential_details"`
+}
+
+// ApacheSparkCredentialRequest is used for creating and updating Apache Spark credentials
+// It doesn't include the UnencryptedCredentialDetails field which is only returned by the API
+type ApacheSparkCredentialRequest struct {
+	ID                *int                     `json:"id,omitempty"`
+	AccountID         int                      `json:"account_id"`
+	ProjectID         int                      `json:"project_id"`
+	Type              string                   `json:"type"`
+	State             int                      `json:"state"`
+	Threads           int                      `json:"threads"`
+	TargetName        string                   `json:"target_name"`
+	AdapterVersion    string                   `json:"adapter_version,omitempty"`
+	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
+}
+
+func (c *Client) GetApacheSparkCredential(
+	projectId int,
+	credentialId int,
+) (*ApacheSparkCredentialData, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+			credentialId,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := ApacheSparkCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func (c *Client) CreateApacheSparkCredential(
+	ctx context.Context,
+	projectId int,
+	token string,
+	schema string,
+	cluster string,
+	targetName string,
+	threads int,
+) (*ApacheSparkCredentialData, error) {
+	credentialDetails, err := GenerateApacheSparkCredentialDetails(
+		token,
+		schema,
+		cluster,
+		threads,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := ApacheSparkCredentialRequest{
+		ID:                nil,
+		AccountID:         c.AccountID,
+		ProjectID:         projectId,
+		Type:              "adapter",
+		State:             STATE_ACTIVE,
+		TargetName:        targetName,
+		Threads:           threads,
+		CredentialDetails: credentialDetails,
+		AdapterVersion:    ApacheSparkConfig{}.AdapterVersion(),
+	}
+
+	rb, err := json.Marshal(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+		),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("CreateApacheSparkCredentialResponse: %s", string(body)))
+
+	credentialResponse := ApacheSparkCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func (c *Client) UpdateApacheSparkCredential(
+	projectId int,
+	credentialId int,
+	apacheSparkCredential ApacheSparkCredentialRequest,
+) (*ApacheSparkCredentialData, error) {
+	rb, err := json.Marshal(apacheSparkCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
+			c.HostURL,
+			c.AccountID,
+			projectId,
+			credentialId,
+		),
+		strings.NewReader(string(rb)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialResponse := ApacheSparkCredentialResponse{}
+	err = json.Unmarshal(body, &credentialResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialResponse.Data, nil
+}
+
+func GenerateApacheSparkCredentialDetails(
+	token string,
+	schema string,
+	cluster string,
+	threads int,
+) (AdapterCredentialDetails, error) {
+	fields := map[string]AdapterCredentialField{
+		"token": {
+			Metadata: AdapterCredentialFieldMetadata{
+				Label:        "Token",
+				Description:  "The token to connect to the Spark cluster",
+				Field_Type:   "text",
+				Encrypt:      true,
+				Overrideable: false,
+				Validation:   AdapterCredentialFieldMetadataValidation{Required: true},
+			},
+			Value: token,
+		},
+		"schema": {
+			Metadata: AdapterCredentialFieldMetadata{
+				Label:        "Schema",
+				Description:  "The schema to build models into",
+				Field_Type:   "text",
+				Encrypt:      false,
+				Overrideable: false,
+				Validation:   AdapterCredentialFieldMetadataValidation{Required: true},
+			},
+			Value: schema,
+		},
+		"cluster": {
+			Metadata: AdapterCredentialFieldMetadata{
+				Label:        "Cluster",
+				Description:  "The ID of the cluster to connect to, overriding the one of the connection",
+				Field_Type:   "text",
+				Encrypt:      false,
+				Overrideable: true,
+				Validation:   AdapterCredentialFieldMetadataValidation{Required: false},
+			},
+			Value: cluster,
+		},
+		"threads": {
+			Metadata: AdapterCredentialFieldMetadata{
+				Label:        "Threads",
+				Description:  "The number of threads to use for dbt operations",
+				Field_Type:   "number",
+				Encrypt:      false,
+				Overrideable: false,
+				Validation:   AdapterCredentialFieldMetadataValidation{Required: true},
+			},
+			Value: threads,
+		},
+	}
+
+	return AdapterCredentialDetails{Fields: fields}, nil
+}
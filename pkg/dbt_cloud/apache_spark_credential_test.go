@@ -0,0 +1,87 @@
+package dbt_cloud_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateApacheSparkCredential(t *testing.T) {
+	var createRequest dbt_cloud.ApacheSparkCredentialRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v3/accounts/123/projects/456/credentials/", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &createRequest))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"id":              789,
+				"account_id":      123,
+				"project_id":      456,
+				"threads":         6,
+				"target_name":     "spark",
+				"adapter_version": "apache_spark_v0",
+				"unencrypted_credential_details": map[string]any{
+					"schema":      "analytics",
+					"cluster":     "my-cluster",
+					"target_name": "spark",
+					"threads":     6,
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	credential, err := client.CreateApacheSparkCredential(
+		context.Background(),
+		456,
+		"my-token",
+		"analytics",
+		"my-cluster",
+		"spark",
+		6,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 789, *credential.ID)
+	assert.Equal(t, "my-cluster", credential.UnencryptedCredentialDetails.Cluster)
+
+	assert.Equal(t, "adapter", createRequest.Type)
+	assert.Equal(t, "apache_spark_v0", createRequest.AdapterVersion)
+	assert.Equal(t, "spark", createRequest.TargetName)
+	assert.Equal(t, 6, createRequest.Threads)
+	assert.Equal(t, "my-token", createRequest.CredentialDetails.Fields["token"].Value)
+	assert.True(t, createRequest.CredentialDetails.Fields["token"].Metadata.Encrypt)
+	assert.Equal(t, "analytics", createRequest.CredentialDetails.Fields["schema"].Value)
+	assert.Equal(t, "my-cluster", createRequest.CredentialDetails.Fields["cluster"].Value)
+	// numbers are decoded as float64 from the JSON payload
+	assert.Equal(t, float64(6), createRequest.CredentialDetails.Fields["threads"].Value)
+}
+
+func TestGetApacheSparkCredential_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/accounts/123/projects/456/credentials/789/", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]any{
+			"status": map[string]any{"code": 404, "is_success": false},
+		})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	_, err := client.GetApacheSparkCredential(456, 789)
+	assert.ErrorContains(t, err, "resource-not-found")
+}
@@ -40,6 +40,8 @@ type Client struct {
 	MaxRetries           int
 	RetriableStatusCodes []string
 	DisableRetry         bool
+	// DisableDeprecationWarnings skips the plan time warnings about deprecated values
+	DisableDeprecationWarnings bool
 }
 
 type ResponseStatus struct {
@@ -0,0 +1,139 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	CONNECTION_TEST_STATUS_PENDING = "pending"
+	CONNECTION_TEST_STATUS_RUNNING = "running"
+	CONNECTION_TEST_STATUS_SUCCESS = "success"
+	CONNECTION_TEST_STATUS_ERROR   = "error"
+)
+
+// ConnectionTestRun is a test of the connection and credential of an environment, as run from the environment settings
+type ConnectionTestRun struct {
+	ID            string `json:"id"`
+	EnvironmentID int    `json:"environment_id"`
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+}
+
+type ConnectionTestRunResponse struct {
+	Data   ConnectionTestRun `json:"data"`
+	Status ResponseStatus    `json:"status"`
+}
+
+// IsComplete returns true when the test has finished, successfully or not
+func (r ConnectionTestRun) IsComplete() bool {
+	return r.Status == CONNECTION_TEST_STATUS_SUCCESS || r.Status == CONNECTION_TEST_STATUS_ERROR
+}
+
+func (c *Client) StartConnectionTest(projectID int, environmentID int) (*ConnectionTestRun, error) {
+	newTestData, err := json.Marshal(map[string]int{"environment_id": environmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/connection-tests/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+		),
+		strings.NewReader(string(newTestData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	testResponse := ConnectionTestRunResponse{}
+	err = json.Unmarshal(body, &testResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &testResponse.Data, nil
+}
+
+func (c *Client) GetConnectionTest(projectID int, testID string) (*ConnectionTestRun, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/connection-tests/%s/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+			testID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	testResponse := ConnectionTestRunResponse{}
+	err = json.Unmarshal(body, &testResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &testResponse.Data, nil
+}
+
+// RunConnectionTest starts a connection test for the environment and polls it until it completes.
+// A failed test is not an error, its status and message are returned to the caller.
+func (c *Client) RunConnectionTest(
+	ctx context.Context,
+	projectID int,
+	environmentID int,
+	pollInterval time.Duration,
+	timeout time.Duration,
+) (*ConnectionTestRun, error) {
+	testRun, err := c.StartConnectionTest(projectID, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	testID := testRun.ID
+	testRun, err = pollUntil(
+		ctx,
+		testRun,
+		func() (*ConnectionTestRun, error) { return c.GetConnectionTest(projectID, testID) },
+		(*ConnectionTestRun).IsComplete,
+		pollInterval,
+		timeout,
+	)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf(
+			"the connection test %s for the environment %d did not complete after %s, its last status was %q",
+			testID,
+			environmentID,
+			timeout,
+			testRun.Status,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return testRun, nil
+}
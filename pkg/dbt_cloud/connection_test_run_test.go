@@ -0,0 +1,72 @@
+package dbt_cloud_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockConnectionTestServer returns a server starting a connection test and returning the statuses in order when it is polled
+func newMockConnectionTestServer(t *testing.T, statuses []string, message string) *httptest.Server {
+	return newMockPollingServer(t, mockPollingServer{
+		createPath:    "/v3/accounts/123/projects/456/connection-tests/",
+		createBody:    `{"environment_id": 10}`,
+		objectPath:    "/v3/accounts/123/projects/456/connection-tests/abc/",
+		initialStatus: dbt_cloud.CONNECTION_TEST_STATUS_PENDING,
+		statuses:      statuses,
+		object: func(status string) any {
+			testRun := dbt_cloud.ConnectionTestRun{ID: "abc", EnvironmentID: 10, Status: status}
+			if testRun.IsComplete() {
+				testRun.Message = message
+			}
+			return testRun
+		},
+	})
+}
+
+func TestRunConnectionTest(t *testing.T) {
+	server := newMockConnectionTestServer(
+		t,
+		[]string{"pending", "running", "success"},
+		"Connection test succeeded",
+	)
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	testRun, err := client.RunConnectionTest(context.Background(), 456, 10, time.Millisecond, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, dbt_cloud.CONNECTION_TEST_STATUS_SUCCESS, testRun.Status)
+	assert.Equal(t, "Connection test succeeded", testRun.Message)
+}
+
+func TestRunConnectionTest_Failure(t *testing.T) {
+	server := newMockConnectionTestServer(
+		t,
+		[]string{"running", "error"},
+		"Database Error: password authentication failed for user \"dbt\"",
+	)
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	testRun, err := client.RunConnectionTest(context.Background(), 456, 10, time.Millisecond, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, dbt_cloud.CONNECTION_TEST_STATUS_ERROR, testRun.Status)
+	assert.Contains(t, testRun.Message, "password authentication failed")
+}
+
+func TestRunConnectionTest_Timeout(t *testing.T) {
+	server := newMockConnectionTestServer(t, []string{"running"}, "")
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	_, err := client.RunConnectionTest(context.Background(), 456, 10, time.Millisecond, 20*time.Millisecond)
+	assert.ErrorContains(t, err, `the connection test abc for the environment 10 did not complete after 20ms, its last status was "running"`)
+}
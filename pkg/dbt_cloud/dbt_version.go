@@ -0,0 +1,53 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// pinnedDbtVersionPattern matches the versions pinned to a dbt minor version, e.g. 1.7.0-latest, the other ones are release tracks
+var pinnedDbtVersionPattern = regexp.MustCompile(`^[0-9]+\.[0-9]+\.0-(latest|pre)$`)
+
+type DbtVersionsResponse struct {
+	Data   []DbtVersion   `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type DbtVersion struct {
+	Version      string  `json:"version"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	IsDeprecated bool    `json:"is_deprecated"`
+	EndOfLife    *string `json:"end_of_life"`
+}
+
+// IsReleaseTrack returns true for the versions that are not pinned to a dbt minor version, e.g. `latest`, `compatible` or `latest-fusion`
+func (v DbtVersion) IsReleaseTrack() bool {
+	return !pinnedDbtVersionPattern.MatchString(v.Version)
+}
+
+func (c *Client) GetDbtVersions() ([]DbtVersion, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/accounts/%d/versions/", c.HostURL, c.AccountID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsResponse := DbtVersionsResponse{}
+	err = json.Unmarshal(body, &versionsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return versionsResponse.Data, nil
+}
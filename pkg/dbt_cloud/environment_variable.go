@@ -48,6 +48,34 @@ func (c *Client) GetEnvironmentVariable(
 	projectID int,
 	environmentVariableName string,
 ) (*FullEnvironmentVariable, error) {
+	environmentVariables, err := c.GetEnvironmentVariables(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentsVariables, _ := environmentVariables[environmentVariableName]
+	if environmentsVariables == nil {
+		return nil, fmt.Errorf(
+			"resource-not-found: Environment variables %s not found in project ID %d",
+			environmentVariableName,
+			projectID,
+		)
+	}
+
+	environmentVariable := FullEnvironmentVariable{
+		Name:                  environmentVariableName,
+		ProjectID:             projectID,
+		EnvironmentNameValues: environmentsVariables,
+	}
+
+	return &environmentVariable, nil
+}
+
+// GetEnvironmentVariables returns the values of all the environment variables of a project, by variable name and then by environment name,
+// the key `project` holding the project default value. The whole matrix is retrieved with a single API call.
+func (c *Client) GetEnvironmentVariables(
+	projectID int,
+) (map[string]map[string]EnvironmentVariableNameValue, error) {
 	req, err := http.NewRequest(
 		"GET",
 		fmt.Sprintf(
@@ -73,22 +101,10 @@ func (c *Client) GetEnvironmentVariable(
 		return nil, err
 	}
 
-	environmentsVariables, _ := environmentVariableResponse.Data.Variables[environmentVariableName]
-	if environmentsVariables == nil {
-		return nil, fmt.Errorf(
-			"resource-not-found: Environment variables %s not found in project ID %d",
-			environmentVariableName,
-			projectID,
-		)
-	}
-
-	environmentVariable := FullEnvironmentVariable{
-		Name:                  environmentVariableName,
-		ProjectID:             projectID,
-		EnvironmentNameValues: environmentsVariables,
+	if environmentVariableResponse.Data.Variables == nil {
+		return map[string]map[string]EnvironmentVariableNameValue{}, nil
 	}
-
-	return &environmentVariable, nil
+	return environmentVariableResponse.Data.Variables, nil
 }
 
 func (c *Client) CreateEnvironmentVariable(
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 )
 
@@ -32,6 +33,31 @@ func (c *Client) GetEnvironmentVariableJobOverride(
 	jobDefinitionID int,
 	environmentVariableOverrideID int,
 ) (*EnvironmentVariableJobOverride, error) {
+	environmentVariableJobOverrides, err := c.GetEnvironmentVariableJobOverrides(
+		projectID,
+		jobDefinitionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, environmentVariableJobOverride := range environmentVariableJobOverrides {
+		if *environmentVariableJobOverride.ID == environmentVariableOverrideID {
+			return &environmentVariableJobOverride, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"resource-not-found: Did not find the override %d",
+		environmentVariableOverrideID,
+	)
+}
+
+// GetEnvironmentVariableJobOverrides returns all the environment variable overrides of a job, with a single API call
+func (c *Client) GetEnvironmentVariableJobOverrides(
+	projectID int,
+	jobDefinitionID int,
+) ([]EnvironmentVariableJobOverride, error) {
 	req, err := http.NewRequest(
 		"GET",
 		fmt.Sprintf(
@@ -58,8 +84,12 @@ func (c *Client) GetEnvironmentVariableJobOverride(
 		return nil, err
 	}
 
-	dataMap := environmentVariableJobOverrideAllResponse.Data.(map[string]interface{})
+	dataMap, ok := environmentVariableJobOverrideAllResponse.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not unpack the data")
+	}
 
+	environmentVariableJobOverrides := []EnvironmentVariableJobOverride{}
 	for envVarName, value := range dataMap {
 		innerMap, ok := value.(map[string]interface{})
 		if !ok {
@@ -68,32 +98,36 @@ func (c *Client) GetEnvironmentVariableJobOverride(
 
 		// the default is to be a float64 when we unmarshall a generic interface{}
 		jobMap, ok := innerMap["job"].(map[string]interface{})
-
-		if ok {
-
-			if overrideID, ok := jobMap["id"].(float64); ok &&
-				int(overrideID) == environmentVariableOverrideID {
-
-				environmentVariableJobOverride := EnvironmentVariableJobOverride{
-					AccountID:       c.AccountID,
-					Name:            envVarName,
-					ProjectID:       projectID,
-					RawValue:        jobMap["value"].(string),
-					Type:            "job",
-					JobDefinitionID: jobDefinitionID,
-					ID:              &environmentVariableOverrideID,
-				}
-
-				return &environmentVariableJobOverride, nil
-			}
+		if !ok {
+			continue
 		}
 
+		overrideID, ok := jobMap["id"].(float64)
+		if !ok {
+			continue
+		}
+		environmentVariableOverrideID := int(overrideID)
+		rawValue, _ := jobMap["value"].(string)
+
+		environmentVariableJobOverrides = append(
+			environmentVariableJobOverrides,
+			EnvironmentVariableJobOverride{
+				AccountID:       c.AccountID,
+				Name:            envVarName,
+				ProjectID:       projectID,
+				RawValue:        rawValue,
+				Type:            "job",
+				JobDefinitionID: jobDefinitionID,
+				ID:              &environmentVariableOverrideID,
+			},
+		)
 	}
 
-	return nil, fmt.Errorf(
-		"resource-not-found: Did not find the override %d",
-		environmentVariableOverrideID,
-	)
+	sort.Slice(environmentVariableJobOverrides, func(i, j int) bool {
+		return environmentVariableJobOverrides[i].Name < environmentVariableJobOverrides[j].Name
+	})
+
+	return environmentVariableJobOverrides, nil
 }
 
 func (c *Client) CreateEnvironmentVariableJobOverride(
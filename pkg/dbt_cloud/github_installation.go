@@ -0,0 +1,136 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type GitHubInstallationAccount struct {
+	Login string `json:"login"`
+	Type  string `json:"type"`
+}
+
+type GitHubInstallation struct {
+	ID      int64                     `json:"id"`
+	Account GitHubInstallationAccount `json:"account"`
+	HTMLURL string                    `json:"html_url"`
+}
+
+type GitHubRepository struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	SSHURL        string `json:"ssh_url"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// GetGitHubInstallations returns the installations of the dbt Cloud GitHub App the user has access to,
+// the v2 integration endpoints return a list without the usual data envelope
+func (c *Client) GetGitHubInstallations() ([]GitHubInstallation, error) {
+
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/v2/integrations/github/installations/", c.HostURL),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitHubInstallations := []GitHubInstallation{}
+	err = json.Unmarshal(body, &gitHubInstallations)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitHubInstallations, nil
+}
+
+// GetGitHubInstallation returns the installation of the dbt Cloud GitHub App on the given GitHub organization or user,
+// GitHub logins are case insensitive
+func (c *Client) GetGitHubInstallation(
+	organization string,
+) (*GitHubInstallation, error) {
+
+	listGitHubInstallations, err := c.GetGitHubInstallations()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, installation := range listGitHubInstallations {
+		if strings.EqualFold(installation.Account.Login, organization) {
+			return &installation, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Did not find any GitHub App installation for the organization = '%s'",
+		organization,
+	)
+}
+
+func (c *Client) GetGitHubRepositories(
+	installationID int64,
+) ([]GitHubRepository, error) {
+
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v2/integrations/github/installations/%d/repositories/",
+			c.HostURL,
+			installationID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitHubRepositories := []GitHubRepository{}
+	err = json.Unmarshal(body, &gitHubRepositories)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitHubRepositories, nil
+}
+
+// GetGitHubRepository returns the repository with the given full name (e.g. `my-org/my-repo`) among the ones
+// the GitHub App installation has access to
+func (c *Client) GetGitHubRepository(
+	fullName string,
+	installationID int64,
+) (*GitHubRepository, error) {
+
+	listGitHubRepositories, err := c.GetGitHubRepositories(installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range listGitHubRepositories {
+		if strings.EqualFold(repository.FullName, fullName) {
+			return &repository, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Did not find any GitHub repository with the full name = '%s' in the GitHub App installation with the ID = '%d'",
+		fullName,
+		installationID,
+	)
+}
@@ -0,0 +1,69 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockGitHubServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]any
+		switch r.URL.Path {
+		case "/v2/integrations/github/installations/":
+			data = []map[string]any{
+				{"id": 11, "account": map[string]any{"login": "other-org", "type": "Organization"}},
+				{"id": 12, "account": map[string]any{"login": "My-Org", "type": "Organization"}},
+			}
+		case "/v2/integrations/github/installations/12/repositories/":
+			data = []map[string]any{
+				{"id": 101, "name": "analytics", "full_name": "My-Org/analytics", "ssh_url": "git@github.com:My-Org/analytics.git"},
+				{"id": 102, "name": "marketing", "full_name": "My-Org/marketing"},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(data)
+	}))
+}
+
+func TestGetGitHubInstallation(t *testing.T) {
+	server := newMockGitHubServer(t)
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	installation, err := client.GetGitHubInstallation("my-org")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), installation.ID)
+	assert.Equal(t, "My-Org", installation.Account.Login)
+
+	_, err = client.GetGitHubInstallation("missing-org")
+	assert.EqualError(t, err, "Did not find any GitHub App installation for the organization = 'missing-org'")
+}
+
+func TestGetGitHubRepository(t *testing.T) {
+	server := newMockGitHubServer(t)
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	repository, err := client.GetGitHubRepository("my-org/analytics", 12)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), repository.ID)
+	assert.Equal(t, "git@github.com:My-Org/analytics.git", repository.SSHURL)
+
+	_, err = client.GetGitHubRepository("my-org/finance", 12)
+	assert.EqualError(
+		t,
+		err,
+		"Did not find any GitHub repository with the full name = 'my-org/finance' in the GitHub App installation with the ID = '12'",
+	)
+}
@@ -0,0 +1,80 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type GitLabProject struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	HTTPURLToRepo     string `json:"http_url_to_repo"`
+	SSHURLToRepo      string `json:"ssh_url_to_repo"`
+	WebURL            string `json:"web_url"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+type GitLabProjectsResponse struct {
+	Data   []GitLabProject `json:"data"`
+	Status ResponseStatus  `json:"status"`
+}
+
+// GetGitLabProjects returns the GitLab projects the user can access through the GitLab integration,
+// search is forwarded to GitLab to filter them and can be empty
+func (c *Client) GetGitLabProjects(search string) ([]GitLabProject, error) {
+
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v3/integrations/gitlab/projects/?account_id=%d&search=%s",
+			c.HostURL,
+			c.AccountID,
+			url.QueryEscape(search),
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitLabProjectListResponse := GitLabProjectsResponse{}
+	err = json.Unmarshal(body, &gitLabProjectListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitLabProjectListResponse.Data, nil
+}
+
+// GetGitLabProject returns the GitLab project with the given path, including its group (e.g. `my-group/my-project`)
+func (c *Client) GetGitLabProject(
+	pathWithNamespace string,
+) (*GitLabProject, error) {
+
+	projectPath := pathWithNamespace[strings.LastIndex(pathWithNamespace, "/")+1:]
+
+	listGitLabProjects, err := c.GetGitLabProjects(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, gitLabProject := range listGitLabProjects {
+		if strings.EqualFold(gitLabProject.PathWithNamespace, pathWithNamespace) {
+			return &gitLabProject, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Did not find any GitLab project with the path = '%s'",
+		pathWithNamespace,
+	)
+}
@@ -0,0 +1,43 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGitLabProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/integrations/gitlab/projects/", r.URL.Path)
+		assert.Equal(t, "123", r.URL.Query().Get("account_id"))
+
+		// GitLab searches on the project name and path, not on the group
+		data := []map[string]any{}
+		if r.URL.Query().Get("search") == "analytics" {
+			data = []map[string]any{
+				{"id": 201, "name": "analytics", "path_with_namespace": "other-group/analytics"},
+				{"id": 202, "name": "analytics", "path_with_namespace": "my-group/sub-group/analytics"},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	project, err := client.GetGitLabProject("my-group/sub-group/analytics")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(202), project.ID)
+
+	_, err = client.GetGitLabProject("my-group/analytics")
+	assert.EqualError(t, err, "Did not find any GitLab project with the path = 'my-group/analytics'")
+
+	_, err = client.GetGitLabProject("my-group/finance")
+	assert.EqualError(t, err, "Did not find any GitLab project with the path = 'my-group/finance'")
+}
@@ -14,11 +14,10 @@ type GlobalConnectionConfig interface {
 }
 
 // TODO: Could be improved in the future, maybe creating a client with empty Config
-// For now, I couldn't use it as the  AdapterVersion is not returned in the GET response
-// To be revisited when we handle different versions for the same adapter
 type GlobalConnectionAdapter struct {
 	Data struct {
 		ID             int64  `json:"id"`
+		Name           string `json:"name"`
 		AdapterVersion string `json:"adapter_version"`
 	} `json:"data"`
 }
@@ -128,7 +127,11 @@ func (c *GlobalConnectionClient[T]) GetWithAdapterVersion(
 	if err != nil {
 		return nil, nil, "", err
 	}
-	adapterVersion := *data.AdapterVersion
+	// the adapter version is not always returned, in which case the default one of the config is used
+	adapterVersion := data.Config.AdapterVersion()
+	if data.AdapterVersion != nil && *data.AdapterVersion != "" {
+		adapterVersion = *data.AdapterVersion
+	}
 	common := data.GlobalConnectionCommon
 	config := data.Config
 	return &common, &config, adapterVersion, nil
@@ -590,3 +593,11 @@ type TeradataConfig struct {
 func (TeradataConfig) AdapterVersion() string {
 	return "teradata_v0"
 }
+
+// CustomConfig is the config of an adapter without a dedicated type, sent as is to dbt Cloud.
+// Its adapter version is not known in advance and needs to be provided when creating or updating the connection.
+type CustomConfig map[string]any
+
+func (CustomConfig) AdapterVersion() string {
+	return ""
+}
@@ -0,0 +1,48 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWithAdapterVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection := map[string]any{
+			"id":     1,
+			"name":   "BigQuery",
+			"config": map[string]any{"project_id": "my-gcp-project"},
+		}
+		switch r.URL.Path {
+		case "/v3/accounts/123/connections/1/":
+			connection["adapter_version"] = "bigquery_v1"
+		case "/v3/accounts/123/connections/2/":
+			// older connections are returned without an adapter version
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": connection})
+	}))
+	defer server.Close()
+
+	client := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.BigQueryConfig](testutil.CreateTestClient(server.URL, 123))
+
+	_, config, adapterVersion, err := client.GetWithAdapterVersion(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-gcp-project", *config.ProjectID)
+	assert.Equal(t, "bigquery_v1", adapterVersion)
+
+	// the default adapter version of the config is used when none is returned
+	_, _, adapterVersion, err = client.GetWithAdapterVersion(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "bigquery_v0", adapterVersion)
+}
@@ -58,30 +58,32 @@ type JobCompletionTriggerCondition struct {
 }
 
 type Job struct {
-	ID                     *int                  `json:"id"`
-	AccountId              int                   `json:"account_id"`
-	ProjectId              int                   `json:"project_id"`
-	EnvironmentId          int                   `json:"environment_id"`
-	Name                   string                `json:"name"`
-	CompareChangesFlags    string                `json:"compare_changes_flags"`
-	DbtVersion             *string               `json:"dbt_version"`
-	DeferringEnvironmentId *int                  `json:"deferring_environment_id"`
-	DeferringJobId         *int                  `json:"deferring_job_definition_id"`
-	Description            string                `json:"description"`
-	ErrorsOnLintFailure    bool                  `json:"errors_on_lint_failure"`
-	ExecuteSteps           []string              `json:"execute_steps"`
-	Execution              JobExecution          `json:"execution"`
-	GenerateDocs           bool                  `json:"generate_docs"`
-	JobCompletionTrigger   *JobCompletionTrigger `json:"job_completion_trigger_condition"`
-	JobType                string                `json:"job_type,omitempty"`
-	RunCompareChanges      bool                  `json:"run_compare_changes"`
-	RunGenerateSources     bool                  `json:"run_generate_sources"`
-	RunLint                bool                  `json:"run_lint"`
-	Schedule               JobSchedule           `json:"schedule"`
-	Settings               JobSettings           `json:"settings"`
-	State                  int                   `json:"state"`
-	TriggersOnDraftPR      bool                  `json:"triggers_on_draft_pr"`
-	Triggers               JobTrigger            `json:"triggers"`
+	ID                       *int                  `json:"id"`
+	AccountId                int                   `json:"account_id"`
+	ProjectId                int                   `json:"project_id"`
+	EnvironmentId            int                   `json:"environment_id"`
+	Name                     string                `json:"name"`
+	CompareChangesFlags      string                `json:"compare_changes_flags"`
+	DbtVersion               *string               `json:"dbt_version"`
+	DeferringEnvironmentId   *int                  `json:"deferring_environment_id"`
+	DeferringJobId           *int                  `json:"deferring_job_definition_id"`
+	Description              string                `json:"description"`
+	ErrorsOnLintFailure      bool                  `json:"errors_on_lint_failure"`
+	ExecuteSteps             []string              `json:"execute_steps"`
+	Execution                JobExecution          `json:"execution"`
+	GenerateDocs             bool                  `json:"generate_docs"`
+	JobCompletionTrigger     *JobCompletionTrigger `json:"job_completion_trigger_condition"`
+	JobType                  string                `json:"job_type,omitempty"`
+	RunCompareChanges        bool                  `json:"run_compare_changes"`
+	RunGenerateSources       bool                  `json:"run_generate_sources"`
+	RunLint                  bool                  `json:"run_lint"`
+	Schedule                 JobSchedule           `json:"schedule"`
+	Settings                 JobSettings           `json:"settings"`
+	State                    int                   `json:"state"`
+	TriggersOnDraftPR        bool                  `json:"triggers_on_draft_pr"`
+	Triggers                 JobTrigger            `json:"triggers"`
+	ForceNodeSelection       *bool                 `json:"force_node_selection,omitempty"`
+	CostOptimizationFeatures *[]string             `json:"cost_optimization_features,omitempty"`
 }
 
 type JobWithEnvironment struct {
@@ -142,6 +144,8 @@ func (c *Client) CreateJob(
 	errorsOnLintFailure bool,
 	jobType string,
 	compareChangesFlags string,
+	forceNodeSelection *bool,
+	costOptimizationFeatures *[]string,
 ) (*Job, error) {
 	state := STATE_ACTIVE
 	if !isActive {
@@ -238,26 +242,28 @@ func (c *Client) CreateJob(
 	}
 
 	newJob := Job{
-		AccountId:            c.AccountID,
-		ProjectId:            projectId,
-		EnvironmentId:        environmentId,
-		Name:                 name,
-		Description:          description,
-		ExecuteSteps:         executeSteps,
-		State:                state,
-		Triggers:             jobTriggers,
-		Settings:             jobSettings,
-		Schedule:             jobSchedule,
-		GenerateDocs:         generateDocs,
-		RunGenerateSources:   runGenerateSources,
-		Execution:            jobExecution,
-		TriggersOnDraftPR:    triggersOnDraftPR,
-		JobCompletionTrigger: jobCompletionTrigger,
-		JobType:              finalJobType,
-		RunCompareChanges:    runCompareChanges,
-		RunLint:              runLint,
-		ErrorsOnLintFailure:  errorsOnLintFailure,
-		CompareChangesFlags:  compareChangesFlags,
+		AccountId:                c.AccountID,
+		ProjectId:                projectId,
+		EnvironmentId:            environmentId,
+		Name:                     name,
+		Description:              description,
+		ExecuteSteps:             executeSteps,
+		State:                    state,
+		Triggers:                 jobTriggers,
+		Settings:                 jobSettings,
+		Schedule:                 jobSchedule,
+		GenerateDocs:             generateDocs,
+		RunGenerateSources:       runGenerateSources,
+		Execution:                jobExecution,
+		TriggersOnDraftPR:        triggersOnDraftPR,
+		JobCompletionTrigger:     jobCompletionTrigger,
+		JobType:                  finalJobType,
+		RunCompareChanges:        runCompareChanges,
+		RunLint:                  runLint,
+		ErrorsOnLintFailure:      errorsOnLintFailure,
+		CompareChangesFlags:      compareChangesFlags,
+		ForceNodeSelection:       forceNodeSelection,
+		CostOptimizationFeatures: costOptimizationFeatures,
 	}
 	if dbtVersion != "" {
 		newJob.DbtVersion = &dbtVersion
@@ -272,15 +278,34 @@ func (c *Client) CreateJob(
 	} else {
 		newJob.DeferringEnvironmentId = nil
 	}
-	newJobData, err := json.Marshal(newJob)
+	createdJob, err := c.CreateJobFromDefinition(newJob)
+	if err != nil {
+		return nil, err
+	}
+
+	if selfDeferring {
+		updatedJob := newJob
+		deferringJobID := *createdJob.ID
+		selfID := *createdJob.ID
+		updatedJob.DeferringJobId = &deferringJobID
+		updatedJob.ID = &selfID
+		return c.UpdateJob(strconv.Itoa(*createdJob.ID), updatedJob)
+	}
+
+	return createdJob, nil
+}
+
+func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
+
+	jobData, err := json.Marshal(job)
 	if err != nil {
 		return nil, err
 	}
 
 	req, err := http.NewRequest(
 		"POST",
-		fmt.Sprintf("%s/v2/accounts/%s/jobs/", c.HostURL, strconv.Itoa(c.AccountID)),
-		strings.NewReader(string(newJobData)),
+		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.Itoa(c.AccountID), jobId),
+		strings.NewReader(string(jobData)),
 	)
 	if err != nil {
 		return nil, err
@@ -297,19 +322,14 @@ func (c *Client) CreateJob(
 		return nil, err
 	}
 
-	if selfDeferring {
-		updatedJob := newJob
-		deferringJobID := *jobResponse.Data.ID
-		selfID := *jobResponse.Data.ID
-		updatedJob.DeferringJobId = &deferringJobID
-		updatedJob.ID = &selfID
-		return c.UpdateJob(strconv.Itoa(*jobResponse.Data.ID), updatedJob)
-	}
-
 	return &jobResponse.Data, nil
 }
 
-func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
+// CreateJobFromDefinition creates a new job from a full job definition, e.g. one built by CreateJob
+// or retrieved with GetJob and modified to copy an existing job
+func (c *Client) CreateJobFromDefinition(job Job) (*Job, error) {
+	job.ID = nil
+	job.AccountId = c.AccountID
 
 	jobData, err := json.Marshal(job)
 	if err != nil {
@@ -318,7 +338,7 @@ func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
 
 	req, err := http.NewRequest(
 		"POST",
-		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.Itoa(c.AccountID), jobId),
+		fmt.Sprintf("%s/v2/accounts/%s/jobs/", c.HostURL, strconv.Itoa(c.AccountID)),
 		strings.NewReader(string(jobData)),
 	)
 	if err != nil {
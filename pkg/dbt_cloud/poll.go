@@ -0,0 +1,45 @@
+package dbt_cloud
+
+import (
+	"context"
+	"time"
+)
+
+// pollUntil calls get every pollInterval until done returns true for the object returned, and returns this object.
+// When current is not nil, it is checked first and the first call to get happens after pollInterval.
+// When the timeout is reached before, the last object retrieved is returned with the error of the context,
+// i.e. context.DeadlineExceeded, which callers can check with errors.Is to describe the timeout.
+func pollUntil[T any](
+	ctx context.Context,
+	current *T,
+	get func() (*T, error),
+	done func(*T) bool,
+	pollInterval time.Duration,
+	timeout time.Duration,
+) (*T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+	if current == nil {
+		current, err = get()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for !done(current) {
+		select {
+		case <-ctx.Done():
+			return current, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		current, err = get()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
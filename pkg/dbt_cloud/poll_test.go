@@ -0,0 +1,51 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockPollingServer describes an object created with a POST and then polled with GETs until its status is final
+type mockPollingServer struct {
+	// path of the POST creating the object and JSON body expected
+	createPath string
+	createBody string
+	// path of the object, polled with GETs and also accepting DELETEs
+	objectPath string
+	// status of the object returned when it is created or deleted
+	initialStatus string
+	// statuses returned in order when the object is polled, the last one being repeated
+	statuses []string
+	// object returns the object to send for a status
+	object func(status string) any
+}
+
+// newMockPollingServer returns a server for the polling helpers of the client, like the connection tests and the PrivateLink endpoints
+func newMockPollingServer(t *testing.T, m mockPollingServer) *httptest.Server {
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := m.initialStatus
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == m.createPath:
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, m.createBody, string(body))
+		case r.Method == http.MethodGet && r.URL.Path == m.objectPath:
+			status = m.statuses[min(polls, len(m.statuses)-1)]
+			polls++
+		case r.Method == http.MethodDelete && r.URL.Path == m.objectPath:
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": m.object(status)})
+	}))
+}
@@ -1,8 +1,19 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	PRIVATELINK_ENDPOINT_STATUS_PENDING = "PENDING"
+	PRIVATELINK_ENDPOINT_STATUS_ACTIVE  = "ACTIVE"
+	PRIVATELINK_ENDPOINT_STATUS_FAILED  = "FAILED"
 )
 
 type PrivatelinkEndpoint struct {
@@ -12,9 +23,19 @@ type PrivatelinkEndpoint struct {
 	PrivatelinkEndpointURL string `json:"private_link_endpoint"`
 	CIDRRange              string `json:"cidr_range"`
 	State                  int    `json:"state"`
+	Status                 string `json:"status,omitempty"`
 	ID                     string `json:"id"`
 }
 
+// PrivatelinkEndpointRequest is a request for dbt Cloud to provision a PrivateLink endpoint
+// to a service exposed by the customer, e.g. an AWS VPC endpoint service or an Azure Private Link service
+type PrivatelinkEndpointRequest struct {
+	AccountID   int    `json:"account_id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	ServiceName string `json:"service_name"`
+}
+
 type PrivatelinkEndpointListResponse struct {
 	Data   []PrivatelinkEndpoint `json:"data"`
 	Status ResponseStatus        `json:"status"`
@@ -76,3 +97,110 @@ func (c *Client) GetAllPrivatelinkEndpoints() ([]PrivatelinkEndpoint, error) {
 
 	return allPrivatelinkEndpoints, nil
 }
+
+func (c *Client) GetPrivatelinkEndpointByID(endpointID string) (*PrivatelinkEndpoint, error) {
+	req, err := http.NewRequest("GET", c.BuildAccountV3URL(ResourcePrivatelinkEndpoints, endpointID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointResponse := PrivatelinkEndpointResponse{}
+	err = json.Unmarshal(body, &endpointResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointResponse.Data, nil
+}
+
+func (c *Client) CreatePrivatelinkEndpoint(
+	name string,
+	endpointType string,
+	serviceName string,
+) (*PrivatelinkEndpoint, error) {
+	newEndpoint := PrivatelinkEndpointRequest{
+		AccountID:   c.AccountID,
+		Name:        name,
+		Type:        endpointType,
+		ServiceName: serviceName,
+	}
+	newEndpointData, err := json.Marshal(newEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		c.BuildAccountV3URL(ResourcePrivatelinkEndpoints),
+		strings.NewReader(string(newEndpointData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointResponse := PrivatelinkEndpointResponse{}
+	err = json.Unmarshal(body, &endpointResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointResponse.Data, nil
+}
+
+func (c *Client) DeletePrivatelinkEndpoint(endpointID string) error {
+	req, err := http.NewRequest("DELETE", c.BuildAccountV3URL(ResourcePrivatelinkEndpoints, endpointID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequestWithRetry(req)
+	return err
+}
+
+// WaitForPrivatelinkEndpoint polls the endpoint until it is active and returns it.
+// When it is not active before the timeout, the last endpoint read is returned with an error wrapping context.DeadlineExceeded.
+func (c *Client) WaitForPrivatelinkEndpoint(
+	ctx context.Context,
+	endpointID string,
+	pollInterval time.Duration,
+	timeout time.Duration,
+) (*PrivatelinkEndpoint, error) {
+	endpoint, err := pollUntil(
+		ctx,
+		nil,
+		func() (*PrivatelinkEndpoint, error) { return c.GetPrivatelinkEndpointByID(endpointID) },
+		func(endpoint *PrivatelinkEndpoint) bool {
+			return endpoint.Status == PRIVATELINK_ENDPOINT_STATUS_ACTIVE ||
+				endpoint.Status == PRIVATELINK_ENDPOINT_STATUS_FAILED
+		},
+		pollInterval,
+		timeout,
+	)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return endpoint, fmt.Errorf(
+			"the PrivateLink endpoint %s is not active after %s, its status is %q: %w",
+			endpointID,
+			timeout,
+			endpoint.Status,
+			err,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Status == PRIVATELINK_ENDPOINT_STATUS_FAILED {
+		return endpoint, fmt.Errorf("the PrivateLink endpoint %s failed to be provisioned", endpointID)
+	}
+	return endpoint, nil
+}
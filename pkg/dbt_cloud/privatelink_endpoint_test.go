@@ -0,0 +1,89 @@
+package dbt_cloud_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockPrivatelinkEndpointServer returns a server creating an endpoint and returning the statuses in order when it is read
+func newMockPrivatelinkEndpointServer(t *testing.T, statuses []string) *httptest.Server {
+	return newMockPollingServer(t, mockPollingServer{
+		createPath:    "/v3/accounts/123/private-link-endpoints/",
+		createBody:    `{"account_id": 123, "name": "my endpoint", "type": "snowflake", "service_name": "com.amazonaws.vpce.us-east-1.vpce-svc-123"}`,
+		objectPath:    "/v3/accounts/123/private-link-endpoints/ple_abc/",
+		initialStatus: dbt_cloud.PRIVATELINK_ENDPOINT_STATUS_PENDING,
+		statuses:      statuses,
+		object: func(status string) any {
+			endpoint := dbt_cloud.PrivatelinkEndpoint{
+				Account_Id: 123,
+				ID:         "ple_abc",
+				Name:       "my endpoint",
+				Type:       "snowflake",
+				State:      dbt_cloud.STATE_ACTIVE,
+				Status:     status,
+			}
+			if status == dbt_cloud.PRIVATELINK_ENDPOINT_STATUS_ACTIVE {
+				endpoint.PrivatelinkEndpointURL = "abc.privatelink.snowflakecomputing.com"
+			}
+			return endpoint
+		},
+	})
+}
+
+func TestCreatePrivatelinkEndpoint(t *testing.T) {
+	server := newMockPrivatelinkEndpointServer(t, []string{"PENDING"})
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	endpoint, err := client.CreatePrivatelinkEndpoint(
+		"my endpoint",
+		"snowflake",
+		"com.amazonaws.vpce.us-east-1.vpce-svc-123",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "ple_abc", endpoint.ID)
+	assert.Equal(t, dbt_cloud.PRIVATELINK_ENDPOINT_STATUS_PENDING, endpoint.Status)
+
+	assert.NoError(t, client.DeletePrivatelinkEndpoint("ple_abc"))
+}
+
+func TestWaitForPrivatelinkEndpoint(t *testing.T) {
+	server := newMockPrivatelinkEndpointServer(t, []string{"PENDING", "PENDING", "ACTIVE"})
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	endpoint, err := client.WaitForPrivatelinkEndpoint(context.Background(), "ple_abc", time.Millisecond, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, dbt_cloud.PRIVATELINK_ENDPOINT_STATUS_ACTIVE, endpoint.Status)
+	assert.Equal(t, "abc.privatelink.snowflakecomputing.com", endpoint.PrivatelinkEndpointURL)
+}
+
+func TestWaitForPrivatelinkEndpoint_Failed(t *testing.T) {
+	server := newMockPrivatelinkEndpointServer(t, []string{"PENDING", "FAILED"})
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	_, err := client.WaitForPrivatelinkEndpoint(context.Background(), "ple_abc", time.Millisecond, time.Second)
+	assert.ErrorContains(t, err, "the PrivateLink endpoint ple_abc failed to be provisioned")
+}
+
+func TestWaitForPrivatelinkEndpoint_Timeout(t *testing.T) {
+	server := newMockPrivatelinkEndpointServer(t, []string{"PENDING"})
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	endpoint, err := client.WaitForPrivatelinkEndpoint(context.Background(), "ple_abc", time.Millisecond, 20*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "ple_abc", endpoint.ID)
+	assert.Equal(t, dbt_cloud.PRIVATELINK_ENDPOINT_STATUS_PENDING, endpoint.Status)
+}
@@ -36,93 +36,6 @@ type ProjectResponse struct {
 
 const InvalidFileCharacters = `#%&{}<>*?$!'":@`
 
-func (c *Client) GetProjectByName(projectName string) (*Project, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequestWithRetry(req)
-	if err != nil {
-		return nil, err
-	}
-
-	projectListResponse := ProjectListResponse{}
-	err = json.Unmarshal(body, &projectListResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	listAllProjects := projectListResponse.Data
-
-	// if there are more than the limit, we need to paginate
-	if projectListResponse.Extra.Pagination.TotalCount > projectListResponse.Extra.Filters.Limit {
-		numProjects := projectListResponse.Extra.Pagination.Count
-		for numProjects < projectListResponse.Extra.Pagination.TotalCount {
-
-			req, err := http.NewRequest(
-				"GET",
-				fmt.Sprintf(
-					"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]&offset=%d",
-					c.HostURL,
-					strconv.Itoa(c.AccountID),
-					numProjects,
-				),
-				nil,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			body, err := c.doRequestWithRetry(req)
-			if err != nil {
-				return nil, err
-			}
-
-			projectListResponse := ProjectListResponse{}
-			err = json.Unmarshal(body, &projectListResponse)
-			if err != nil {
-				return nil, err
-			}
-
-			numProjectsLastCall := projectListResponse.Extra.Pagination.Count
-			if numProjectsLastCall > 0 {
-				listAllProjects = append(listAllProjects, projectListResponse.Data...)
-				numProjects += projectListResponse.Extra.Pagination.Count
-			} else {
-				// this means that most likely one item was deleted since the first call
-				// so the number of items is less than the initial total, we can break the loop
-				break
-			}
-
-		}
-	}
-
-	// we now loop though the projects to find the ones with the name we are looking for
-	matchingProjects := []Project{}
-	for _, project := range listAllProjects {
-		if strings.EqualFold(project.Name, projectName) {
-			matchingProjects = append(matchingProjects, project)
-		}
-	}
-
-	if len(matchingProjects) == 0 {
-		return nil, fmt.Errorf("Did not find any project with the name: %s", projectName)
-	} else if len(matchingProjects) > 1 {
-		return nil, fmt.Errorf("Found more than one project with the name: %s", projectName)
-	}
-
-	return &matchingProjects[0], nil
-}
-
 func (c *Client) GetProject(projectID string) (*Project, error) {
 	req, err := http.NewRequest(
 		"GET",
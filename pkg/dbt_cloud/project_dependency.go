@@ -0,0 +1,129 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ProjectDependency is a dependency of a project on an upstream project, used with dbt Mesh
+// to reference the public models of the upstream project
+type ProjectDependency struct {
+	ID                *int `json:"id,omitempty"`
+	AccountID         int  `json:"account_id"`
+	ProjectID         int  `json:"project_id"`
+	UpstreamProjectID int  `json:"upstream_project_id"`
+	State             int  `json:"state,omitempty"`
+}
+
+type ProjectDependencyResponse struct {
+	Data   ProjectDependency `json:"data"`
+	Status ResponseStatus    `json:"status"`
+}
+
+func (c *Client) GetProjectDependencies(projectID int) ([]ProjectDependency, error) {
+	url := fmt.Sprintf(
+		"%s/v3/accounts/%d/projects/%d/dependencies/",
+		c.HostURL,
+		c.AccountID,
+		projectID,
+	)
+
+	allDependenciesRaw, err := c.GetRawData(url)
+	if err != nil {
+		return nil, err
+	}
+
+	allDependencies := []ProjectDependency{}
+	for _, dependencyRaw := range allDependenciesRaw {
+		currentDependency := ProjectDependency{}
+		err := json.Unmarshal(dependencyRaw, &currentDependency)
+		if err != nil {
+			return nil, err
+		}
+		allDependencies = append(allDependencies, currentDependency)
+	}
+	return allDependencies, nil
+}
+
+// GetProjectDependency returns the dependency of the project on the upstream project,
+// and a resource-not-found error if the project doesn't depend on it.
+// The dependencies can't be retrieved by upstream project, so all the ones of the project are listed.
+func (c *Client) GetProjectDependency(projectID int, upstreamProjectID int) (*ProjectDependency, error) {
+	dependencies, err := c.GetProjectDependencies(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dependency := range dependencies {
+		if dependency.UpstreamProjectID == upstreamProjectID && dependency.State != STATE_DELETED {
+			return &dependency, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"resource-not-found: project %d doesn't depend on project %d",
+		projectID,
+		upstreamProjectID,
+	)
+}
+
+func (c *Client) CreateProjectDependency(projectID int, upstreamProjectID int) (*ProjectDependency, error) {
+	newDependency := ProjectDependency{
+		AccountID:         c.AccountID,
+		ProjectID:         projectID,
+		UpstreamProjectID: upstreamProjectID,
+	}
+	newDependencyData, err := json.Marshal(newDependency)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/dependencies/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+		),
+		strings.NewReader(string(newDependencyData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencyResponse := ProjectDependencyResponse{}
+	err = json.Unmarshal(body, &dependencyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dependencyResponse.Data, nil
+}
+
+func (c *Client) DeleteProjectDependency(projectID int, dependencyID int) error {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/projects/%d/dependencies/%d/",
+			c.HostURL,
+			c.AccountID,
+			projectID,
+			dependencyID,
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequestWithRetry(req)
+	return err
+}
@@ -0,0 +1,66 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectDependency(t *testing.T) {
+	deleted := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/accounts/123/projects/10/dependencies/":
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{
+					{"id": 1, "account_id": 123, "project_id": 10, "upstream_project_id": 20, "state": dbt_cloud.STATE_DELETED},
+					{"id": 2, "account_id": 123, "project_id": 10, "upstream_project_id": 30, "state": dbt_cloud.STATE_ACTIVE},
+				},
+				"extra": map[string]any{"pagination": map[string]any{"count": 2, "total_count": 2}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/accounts/123/projects/10/dependencies/":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"account_id": 123, "project_id": 10, "upstream_project_id": 20}`, string(body))
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"id": 3, "account_id": 123, "project_id": 10, "upstream_project_id": 20, "state": dbt_cloud.STATE_ACTIVE},
+			})
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	dependencies, err := client.GetProjectDependencies(10)
+	assert.NoError(t, err)
+	assert.Len(t, dependencies, 2)
+
+	dependency, err := client.GetProjectDependency(10, 30)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *dependency.ID)
+
+	// deleted dependencies are ignored
+	_, err = client.GetProjectDependency(10, 20)
+	assert.EqualError(t, err, "resource-not-found: project 10 doesn't depend on project 20")
+
+	dependency, err = client.CreateProjectDependency(10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *dependency.ID)
+	assert.Equal(t, 20, dependency.UpstreamProjectID)
+
+	assert.NoError(t, client.DeleteProjectDependency(10, 3))
+	assert.Equal(t, []string{"/v3/accounts/123/projects/10/dependencies/3/"}, deleted)
+}
@@ -3,6 +3,11 @@ package dbt_cloud
 import (
 	"encoding/json"
 	"fmt"
+	neturl "net/url"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
 )
 
 type ProjectConnectionRepository struct {
@@ -22,39 +27,45 @@ type ProjectConnectionRepository struct {
 	CreatedAt              string                                `json:"created_at,omitempty"`
 	UpdatedAt              string                                `json:"updated_at,omitempty"`
 	Connection             *globalConnectionPayload[EmptyConfig] `json:"connection,omitempty"`
-	Environments           any                                   `json:"environments,omitempty"`
+	Environments           []Environment                         `json:"environments,omitempty"`
 	Repository             *Repository                           `json:"repository,omitempty"`
 	GroupPermissions       any                                   `json:"group_permissions,omitempty"`
 	DocsJob                any                                   `json:"docs_job,omitempty"`
 	FreshnessJob           any                                   `json:"freshness_job,omitempty"`
 }
 
+// projectListIncludeRelated lists the objects returned together with the projects when listing them
+const projectListIncludeRelated = `["repository","connection"]`
+
+// projectIncludeRelated lists the objects returned together with a single project,
+// to get the repository, connection and environments of the project in one call.
+// The environments are not requested when listing projects, as they make the pages of large accounts much bigger.
+const projectIncludeRelated = `["repository","connection","environments","freshness_job_id","docs_job_id"]`
+
 func (c *Client) GetAllProjects(nameContains string) ([]ProjectConnectionRepository, error) {
-	var url string
+	return c.getProjects(nameContains, projectListIncludeRelated)
+}
 
-	if nameContains == "" {
-		url = fmt.Sprintf(
-			`%s/v3/accounts/%d/projects/?limit=100&order_by=name&include_related=["repository","connection"]`,
-			c.HostURL,
-			c.AccountID,
-		)
-	} else {
-		url = fmt.Sprintf(
-			`%s/v3/accounts/%d/projects/?name__icontains=%s&limit=100&order_by=name&include_related=["repository","connection"]`,
-			c.HostURL,
-			c.AccountID,
-			nameContains,
-		)
+func (c *Client) getProjects(nameContains string, includeRelated string) ([]ProjectConnectionRepository, error) {
+	url := fmt.Sprintf(
+		`%s/v3/accounts/%d/projects/?limit=100&order_by=name&include_related=%s`,
+		c.HostURL,
+		c.AccountID,
+		includeRelated,
+	)
+	if nameContains != "" {
+		url = fmt.Sprintf("%s&name__icontains=%s", url, neturl.QueryEscape(nameContains))
 	}
 
-	allProjectsRaw := c.GetData(url)
+	allProjectsRaw, err := c.GetRawData(url)
+	if err != nil {
+		return nil, err
+	}
 
 	allProjects := []ProjectConnectionRepository{}
-	for _, job := range allProjectsRaw {
-
-		data, _ := json.Marshal(job)
+	for _, projectRaw := range allProjectsRaw {
 		currentProject := ProjectConnectionRepository{}
-		err := json.Unmarshal(data, &currentProject)
+		err := json.Unmarshal(projectRaw, &currentProject)
 		if err != nil {
 			return nil, err
 		}
@@ -62,3 +73,56 @@ func (c *Client) GetAllProjects(nameContains string) ([]ProjectConnectionReposit
 	}
 	return allProjects, nil
 }
+
+// GetProjectByName returns the project with the given name, ignoring the case, and an error if there is none or more than one.
+// The projects are filtered by the API so that only the ones containing the name need to be paginated through,
+// and they are returned with the same related objects as GetProjectWithRelated.
+func (c *Client) GetProjectByName(projectName string) (*ProjectConnectionRepository, error) {
+	candidateProjects, err := c.getProjects(projectName, projectIncludeRelated)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingProjects := lo.Filter(candidateProjects, func(project ProjectConnectionRepository, _ int) bool {
+		return strings.EqualFold(project.Name, projectName)
+	})
+
+	if len(matchingProjects) == 0 {
+		return nil, fmt.Errorf("Did not find any project with the name: %s", projectName)
+	} else if len(matchingProjects) > 1 {
+		projectIDs := lo.Map(matchingProjects, func(project ProjectConnectionRepository, _ int) string {
+			return strconv.FormatInt(project.ID, 10)
+		})
+		return nil, fmt.Errorf(
+			"Found more than one project with the name: %s (project IDs: %s)",
+			projectName,
+			strings.Join(projectIDs, ", "),
+		)
+	}
+
+	return &matchingProjects[0], nil
+}
+
+// GetProjectWithRelated returns the project together with its repository, connection and environments
+func (c *Client) GetProjectWithRelated(projectID int64) (*ProjectConnectionRepository, error) {
+	body, err := c.GetEndpoint(fmt.Sprintf(
+		`%s/v3/accounts/%d/projects/%d/?include_related=%s`,
+		c.HostURL,
+		c.AccountID,
+		projectID,
+		projectIncludeRelated,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := struct {
+		Data ProjectConnectionRepository `json:"data"`
+	}{}
+	err = json.Unmarshal(body, &projectResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectResponse.Data, nil
+}
@@ -0,0 +1,84 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockProjectsServer returns a server listing the projects by pages of 2, filtering them on name__icontains like the API does
+func newMockProjectsServer(t *testing.T, accountID int, projects []map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v3/accounts/%d/projects/", accountID), r.URL.Path)
+		// the environments are only requested when looking up a project by name
+		nameContains := r.URL.Query().Get("name__icontains")
+		if nameContains != "" {
+			assert.Contains(t, r.URL.Query().Get("include_related"), "environments")
+		} else {
+			assert.Equal(t, `["repository","connection"]`, r.URL.Query().Get("include_related"))
+		}
+
+		filtered := []map[string]any{}
+		for _, project := range projects {
+			if nameContains == "" || strings.Contains(strings.ToLower(project["name"].(string)), strings.ToLower(nameContains)) {
+				filtered = append(filtered, project)
+			}
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := min(offset+2, len(filtered))
+		page := filtered[min(offset, end):end]
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": page,
+			"extra": map[string]any{
+				"pagination": map[string]any{"count": len(page), "total_count": len(filtered)},
+			},
+		})
+	}))
+}
+
+func TestGetProjectByName(t *testing.T) {
+	const accountID = 123
+
+	server := newMockProjectsServer(t, accountID, []map[string]any{
+		{"id": 1, "name": "Analytics staging"},
+		{"id": 2, "name": "Marketing"},
+		{"id": 3, "name": "Finance"},
+		{"id": 4, "name": "Analytics"},
+		{"id": 5, "name": "Analytics old"},
+		{"id": 6, "name": "Sales"},
+		{"id": 7, "name": "sales"},
+		{"id": 8, "name": "Sales staging", "environments": []map[string]any{{"id": 80, "name": "Prod"}}},
+	})
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, accountID)
+
+	// the match is on the last page of the filtered projects
+	project, err := client.GetProjectByName("analytics")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), project.ID)
+
+	_, err = client.GetProjectByName("Operations")
+	assert.EqualError(t, err, "Did not find any project with the name: Operations")
+
+	_, err = client.GetProjectByName("Sales")
+	assert.EqualError(t, err, "Found more than one project with the name: Sales (project IDs: 6, 7)")
+
+	project, err = client.GetProjectByName("sales staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "Prod", project.Environments[0].Name)
+
+	projects, err := client.GetAllProjects("")
+	assert.NoError(t, err)
+	assert.Len(t, projects, 8)
+}
@@ -36,6 +36,11 @@ type DeployKey struct {
 	PublicKey string `json:"public_key"`
 }
 
+type DeployKeyResponse struct {
+	Data   DeployKey      `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
 type RepositoryListResponse struct {
 	Data   []Repository   `json:"data"`
 	Status ResponseStatus `json:"status"`
@@ -77,6 +82,32 @@ func (c *Client) GetRepository(
 	return &repositoryResponse.Data, nil
 }
 
+// GetProjectRepositories returns all the repositories of a project, including the ones not currently linked to it
+func (c *Client) GetProjectRepositories(projectID int) ([]Repository, error) {
+	url := fmt.Sprintf(
+		"%s/v3/accounts/%d/projects/%d/repositories/",
+		c.HostURL,
+		c.AccountID,
+		projectID,
+	)
+
+	allRepositoriesRaw, err := c.GetRawData(url)
+	if err != nil {
+		return nil, err
+	}
+
+	allRepositories := []Repository{}
+	for _, repositoryRaw := range allRepositoriesRaw {
+		currentRepository := Repository{}
+		err := json.Unmarshal(repositoryRaw, &currentRepository)
+		if err != nil {
+			return nil, err
+		}
+		allRepositories = append(allRepositories, currentRepository)
+	}
+	return allRepositories, nil
+}
+
 func (c *Client) CreateRepository(
 	projectID int,
 	remoteUrl string,
@@ -247,3 +278,68 @@ func (c *Client) DeleteRepository(repositoryID, projectID string) (string, error
 
 	return "", err
 }
+
+// CreateDeployKey generates a new SSH deploy key in the account
+func (c *Client) CreateDeployKey() (*DeployKey, error) {
+	newDeployKeyData, err := json.Marshal(map[string]int{"account_id": c.AccountID})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/v3/accounts/%d/deploy-keys/", c.HostURL, c.AccountID),
+		strings.NewReader(string(newDeployKeyData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deployKeyResponse := DeployKeyResponse{}
+	err = json.Unmarshal(body, &deployKeyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deployKeyResponse.Data, nil
+}
+
+// RotateRepositoryDeployKey replaces the deploy key of a repository using the `deploy_key` clone strategy with a new one,
+// without recreating the repository. The new public key needs to be added to the git provider for dbt Cloud to keep cloning the repository.
+func (c *Client) RotateRepositoryDeployKey(repositoryID, projectID string) (*Repository, error) {
+	repository, err := c.GetRepository(repositoryID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.GitCloneStrategy != "deploy_key" {
+		return nil, fmt.Errorf(
+			"the deploy key can only be rotated for repositories using the deploy_key clone strategy, repository %s uses %s",
+			repositoryID,
+			repository.GitCloneStrategy,
+		)
+	}
+
+	deployKey, err := c.CreateDeployKey()
+	if err != nil {
+		return nil, err
+	}
+
+	repository.DeployKeyID = &deployKey.ID
+	repository.DeployKey = nil
+
+	updatedRepository, err := c.UpdateRepository(repositoryID, projectID, *repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedRepository.DeployKey == nil || updatedRepository.DeployKey.ID != deployKey.ID {
+		updatedRepository.DeployKey = deployKey
+	}
+	return updatedRepository, nil
+}
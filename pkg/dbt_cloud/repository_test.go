@@ -82,4 +82,88 @@ func TestCreateRepository_PreservesRemoteBackendAndFullName(t *testing.T) {
 	if *updateReq.RepositoryCredentialsID != credentialsID {
 		t.Errorf("Expected update request to have RepositoryCredentialsID %d, got %d", credentialsID, *updateReq.RepositoryCredentialsID)
 	}
-}
\ No newline at end of file
+}
+func TestRotateRepositoryDeployKey(t *testing.T) {
+	const (
+		accountID    = 123
+		projectID    = 456
+		repositoryID = 789
+		oldKeyID     = 101
+		newKeyID     = 102
+	)
+
+	server := testutil.NewMockRepositoryServer(accountID, projectID, repositoryID)
+	defer server.Close()
+
+	server.SetGetResponse(&dbt_cloud.RepositoryResponse{
+		Data: dbt_cloud.Repository{
+			ID:               testutil.IntPtr(repositoryID),
+			ProjectID:        projectID,
+			RemoteUrl:        "git@github.com:test/repo.git",
+			GitCloneStrategy: "deploy_key",
+			DeployKeyID:      testutil.IntPtr(oldKeyID),
+			DeployKey:        &dbt_cloud.DeployKey{ID: oldKeyID, PublicKey: "ssh-rsa OLD"},
+		},
+	})
+	server.SetDeployKeyResponse(&dbt_cloud.DeployKeyResponse{
+		Data: dbt_cloud.DeployKey{ID: newKeyID, PublicKey: "ssh-rsa NEW"},
+	})
+	server.SetUpdateResponse(&dbt_cloud.RepositoryResponse{
+		Data: dbt_cloud.Repository{
+			ID:               testutil.IntPtr(repositoryID),
+			ProjectID:        projectID,
+			GitCloneStrategy: "deploy_key",
+			DeployKeyID:      testutil.IntPtr(newKeyID),
+		},
+	})
+
+	client := testutil.CreateTestClient(server.URL(), accountID)
+
+	repository, err := client.RotateRepositoryDeployKey("789", "456")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	updateReq := server.GetLastUpdateRequest()
+	if updateReq == nil {
+		t.Fatal("Expected update request to be made")
+	}
+	if *updateReq.DeployKeyID != newKeyID {
+		t.Errorf("Expected update request to have DeployKeyID %d, got %d", newKeyID, *updateReq.DeployKeyID)
+	}
+	if updateReq.RemoteUrl != "git@github.com:test/repo.git" {
+		t.Errorf("Expected update request to keep the remote URL, got '%s'", updateReq.RemoteUrl)
+	}
+	if repository.DeployKey == nil || repository.DeployKey.PublicKey != "ssh-rsa NEW" {
+		t.Errorf("Expected the new public key to be returned, got %v", repository.DeployKey)
+	}
+}
+
+func TestRotateRepositoryDeployKey_RequiresDeployKeyStrategy(t *testing.T) {
+	const (
+		accountID    = 123
+		projectID    = 456
+		repositoryID = 789
+	)
+
+	server := testutil.NewMockRepositoryServer(accountID, projectID, repositoryID)
+	defer server.Close()
+
+	server.SetGetResponse(&dbt_cloud.RepositoryResponse{
+		Data: dbt_cloud.Repository{
+			ID:               testutil.IntPtr(repositoryID),
+			ProjectID:        projectID,
+			GitCloneStrategy: "github_app",
+		},
+	})
+
+	client := testutil.CreateTestClient(server.URL(), accountID)
+
+	_, err := client.RotateRepositoryDeployKey("789", "456")
+	if err == nil {
+		t.Fatal("Expected an error for a repository not using the deploy_key strategy")
+	}
+	if server.GetLastUpdateRequest() != nil {
+		t.Error("Expected no update request to be made")
+	}
+}
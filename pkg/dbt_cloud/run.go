@@ -17,6 +17,26 @@ type Run struct {
 	GitHubPullRequestID string `json:"github_pull_request_id,omitempty"`
 	SchemaOverride      string `json:"schema_override,omitempty"`
 	Cause               string `json:"cause,omitempty"`
+	Status              int    `json:"status,omitempty"`
+	FinishedAt          string `json:"finished_at,omitempty"`
+}
+
+const (
+	RUN_STATUS_QUEUED    = 1
+	RUN_STATUS_STARTING  = 2
+	RUN_STATUS_RUNNING   = 3
+	RUN_STATUS_SUCCESS   = 10
+	RUN_STATUS_ERROR     = 20
+	RUN_STATUS_CANCELLED = 30
+)
+
+var RunStatusesHumanized = map[int]string{
+	RUN_STATUS_QUEUED:    "queued",
+	RUN_STATUS_STARTING:  "starting",
+	RUN_STATUS_RUNNING:   "running",
+	RUN_STATUS_SUCCESS:   "success",
+	RUN_STATUS_ERROR:     "error",
+	RUN_STATUS_CANCELLED: "cancelled",
 }
 
 type RunResponse struct {
@@ -39,6 +59,7 @@ type RunFilter struct {
 	PullRequestID   int    `json:"pull_request_id"`
 	Status          int    `json:"status"`
 	StatusIn        string `json:"status_in"`
+	OrderBy         string `json:"order_by"`
 }
 
 func (c *Client) GetRun(runID int64) (*Run, error) {
@@ -111,6 +132,9 @@ func (c *Client) GetRuns(filter *RunFilter) (*[]Run, error) {
 		if filter.StatusIn != "" {
 			query.Add("status_in", filter.StatusIn)
 		}
+		if filter.OrderBy != "" {
+			query.Add("order_by", filter.OrderBy)
+		}
 	}
 	req.URL.RawQuery = query.Encode()
 
@@ -129,6 +153,25 @@ func (c *Client) GetRuns(filter *RunFilter) (*[]Run, error) {
 	return &response.Data, nil
 }
 
+// GetLatestJobRun returns the most recent run of a job, only considering the runs with the given status if it is not 0.
+// It returns nil if the job doesn't have any matching run.
+func (c *Client) GetLatestJobRun(jobID int, status int) (*Run, error) {
+	runs, err := c.GetRuns(&RunFilter{
+		JobDefinitionID: jobID,
+		Status:          status,
+		Limit:           1,
+		OrderBy:         "-id",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(*runs) == 0 {
+		return nil, nil
+	}
+	return &(*runs)[0], nil
+}
+
 func (c *Client) TriggerRun(
 	jobID int,
 	gitSHA string,
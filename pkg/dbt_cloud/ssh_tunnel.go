@@ -0,0 +1,65 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SSH tunnels are stored as encryptions of a connection, the key pair is generated by dbt Cloud when the encryption is created.
+// They are created, updated and deleted with CreateUpdateEncryption.
+
+// GetSSHTunnel returns an active SSH tunnel, a deleted one is reported as not found
+func (c *Client) GetSSHTunnel(sshTunnelID int64) (*GlobalConnectionEncryptionPayload, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf(
+			"%s/v2/accounts/%d/encryptions/%d/",
+			c.HostURL,
+			c.AccountID,
+			sshTunnelID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sshTunnelResponse := globalConnectionEncryptionResponse{}
+	err = json.Unmarshal(body, &sshTunnelResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if sshTunnelResponse.Data.State == STATE_DELETED {
+		return nil, fmt.Errorf("resource-not-found: the SSH tunnel %d has been deleted", sshTunnelID)
+	}
+
+	return &sshTunnelResponse.Data, nil
+}
+
+// GetAllSSHTunnels returns the active SSH tunnels of all the connections of the account
+func (c *Client) GetAllSSHTunnels() ([]GlobalConnectionEncryptionPayload, error) {
+	url := fmt.Sprintf("%s/v2/accounts/%d/encryptions/?state=%d", c.HostURL, c.AccountID, STATE_ACTIVE)
+
+	allSSHTunnelsRaw, err := c.GetRawData(url)
+	if err != nil {
+		return nil, err
+	}
+
+	allSSHTunnels := []GlobalConnectionEncryptionPayload{}
+	for _, sshTunnelRaw := range allSSHTunnelsRaw {
+		sshTunnel := GlobalConnectionEncryptionPayload{}
+		err := json.Unmarshal(sshTunnelRaw, &sshTunnel)
+		if err != nil {
+			return nil, err
+		}
+		allSSHTunnels = append(allSSHTunnels, sshTunnel)
+	}
+	return allSSHTunnels, nil
+}
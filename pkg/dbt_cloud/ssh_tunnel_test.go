@@ -0,0 +1,91 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSSHTunnel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sshTunnelID := int64(456)
+		sshTunnel := dbt_cloud.GlobalConnectionEncryptionPayload{
+			ID:           &sshTunnelID,
+			AccountID:    123,
+			ConnectionID: 789,
+			Username:     "dbt",
+			Port:         22,
+			HostName:     "bastion.example.com",
+			PublicKey:    "ssh-rsa AAAA",
+			State:        dbt_cloud.STATE_ACTIVE,
+		}
+
+		switch r.URL.Path {
+		case "/v2/accounts/123/encryptions/456/":
+		case "/v2/accounts/123/encryptions/457/":
+			sshTunnel.State = dbt_cloud.STATE_DELETED
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": sshTunnel})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	sshTunnel, err := client.GetSSHTunnel(456)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(789), sshTunnel.ConnectionID)
+	assert.Equal(t, "bastion.example.com", sshTunnel.HostName)
+	assert.Equal(t, "ssh-rsa AAAA", sshTunnel.PublicKey)
+
+	_, err = client.GetSSHTunnel(457)
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "resource-not-found"))
+}
+
+func TestGetAllSSHTunnels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/accounts/123/encryptions/", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("state"))
+
+		firstID, secondID := int64(1), int64(2)
+		sshTunnels := []dbt_cloud.GlobalConnectionEncryptionPayload{
+			{ID: &firstID, AccountID: 123, ConnectionID: 10, Username: "dbt", Port: 22, HostName: "a.example.com", PublicKey: "ssh-rsa A", State: 1},
+			{ID: &secondID, AccountID: 123, ConnectionID: 20, Username: "dbt", Port: 2222, HostName: "b.example.com", PublicKey: "ssh-rsa B", State: 1},
+		}
+		// one SSH tunnel per page to check that all the pages are read
+		data := sshTunnels[:1]
+		if r.URL.Query().Get("offset") == "1" {
+			data = sshTunnels[1:]
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": data,
+			"extra": map[string]any{
+				"pagination": map[string]any{"count": len(data), "total_count": len(sshTunnels)},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := testutil.CreateTestClient(server.URL, 123)
+
+	sshTunnels, err := client.GetAllSSHTunnels()
+	assert.NoError(t, err)
+	assert.Len(t, sshTunnels, 2)
+	assert.Equal(t, int64(10), sshTunnels[0].ConnectionID)
+	assert.Equal(t, int64(2222), sshTunnels[1].Port)
+	assert.Equal(t, "ssh-rsa B", sshTunnels[1].PublicKey)
+}
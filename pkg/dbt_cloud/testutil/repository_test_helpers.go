@@ -15,6 +15,8 @@ type MockRepositoryServer struct {
 	server            *httptest.Server
 	createResponse    *dbt_cloud.RepositoryResponse
 	updateResponse    *dbt_cloud.RepositoryResponse
+	getResponse       *dbt_cloud.RepositoryResponse
+	deployKeyResponse *dbt_cloud.DeployKeyResponse
 	accountID         int
 	projectID         int
 	repositoryID      int
@@ -27,6 +29,22 @@ func (m *MockRepositoryServer) handleRequest(w http.ResponseWriter, r *http.Requ
 	createPath := fmt.Sprintf("/v3/accounts/%d/projects/%d/repositories/", m.accountID, m.projectID)
 	updatePath := fmt.Sprintf("/v3/accounts/%d/projects/%d/repositories/%d/", m.accountID, m.projectID, m.repositoryID)
 
+	deployKeyPath := fmt.Sprintf("/v3/accounts/%d/deploy-keys/", m.accountID)
+
+	if r.Method == "GET" && r.URL.Path == updatePath {
+		if m.getResponse != nil {
+			json.NewEncoder(w).Encode(m.getResponse)
+			return
+		}
+	}
+
+	if r.Method == "POST" && r.URL.Path == deployKeyPath {
+		if m.deployKeyResponse != nil {
+			json.NewEncoder(w).Encode(m.deployKeyResponse)
+			return
+		}
+	}
+
 	if r.Method == "POST" && r.URL.Path == createPath {
 		if m.createResponse != nil {
 			json.NewEncoder(w).Encode(m.createResponse)
@@ -78,6 +96,14 @@ func (m *MockRepositoryServer) SetUpdateResponse(response *dbt_cloud.RepositoryR
 	m.updateResponse = response
 }
 
+func (m *MockRepositoryServer) SetGetResponse(response *dbt_cloud.RepositoryResponse) {
+	m.getResponse = response
+}
+
+func (m *MockRepositoryServer) SetDeployKeyResponse(response *dbt_cloud.DeployKeyResponse) {
+	m.deployKeyResponse = response
+}
+
 func (m *MockRepositoryServer) Close() {
 	m.server.Close()
 }
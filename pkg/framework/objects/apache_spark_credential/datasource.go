@@ -0,0 +1,106 @@
+package apache_spark_credential
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apacheSparkCredentialDataSource{}
+	_ datasource.DataSourceWithConfigure = &apacheSparkCredentialDataSource{}
+)
+
+// ApacheSparkCredentialDataSource is a helper function to simplify the provider implementation.
+func ApacheSparkCredentialDataSource() datasource.DataSource {
+	return &apacheSparkCredentialDataSource{}
+}
+
+// apacheSparkCredentialDataSource is the data source implementation.
+type apacheSparkCredentialDataSource struct {
+	client *dbt_cloud.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apacheSparkCredentialDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *apacheSparkCredentialDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_apache_spark_credential"
+}
+
+// Schema defines the schema for the data source.
+func (d *apacheSparkCredentialDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apacheSparkCredentialDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state ApacheSparkCredentialDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+	credentialID := int(state.CredentialID.ValueInt64())
+
+	credential, err := d.client.GetApacheSparkCredential(projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Apache Spark credential",
+			fmt.Sprintf("Could not read Apache Spark credential ID %d: %s", credentialID, err.Error()),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, credentialID))
+	state.Schema = types.StringValue(credential.UnencryptedCredentialDetails.Schema)
+	state.Cluster = types.StringValue(credential.UnencryptedCredentialDetails.Cluster)
+	state.TargetName = types.StringValue(credential.TargetName)
+	state.Threads = types.Int64Value(int64(credential.Threads))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,46 @@
+package apache_spark_credential_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudApacheSparkCredentialDataSource(t *testing.T) {
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	schema := "test_schema"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "%s"
+}
+
+resource "dbtcloud_apache_spark_credential" "test" {
+  project_id = dbtcloud_project.test.id
+  schema     = "%s"
+  token      = "test_token"
+  threads    = 6
+}
+
+data "dbtcloud_apache_spark_credential" "test" {
+  project_id    = dbtcloud_project.test.id
+  credential_id = dbtcloud_apache_spark_credential.test.credential_id
+}
+`, projectName, schema),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_apache_spark_credential.test", "id"),
+					resource.TestCheckResourceAttr("data.dbtcloud_apache_spark_credential.test", "schema", schema),
+					resource.TestCheckResourceAttr("data.dbtcloud_apache_spark_credential.test", "threads", "6"),
+				),
+			},
+		},
+	})
+}
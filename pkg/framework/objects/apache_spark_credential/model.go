@@ -0,0 +1,28 @@
+package apache_spark_credential
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApacheSparkCredentialResourceModel is the model for the resource
+type ApacheSparkCredentialResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	CredentialID types.Int64  `tfsdk:"credential_id"`
+	ProjectID    types.Int64  `tfsdk:"project_id"`
+	Token        types.String `tfsdk:"token"`
+	Schema       types.String `tfsdk:"schema"`
+	Cluster      types.String `tfsdk:"cluster"`
+	TargetName   types.String `tfsdk:"target_name"`
+	Threads      types.Int64  `tfsdk:"threads"`
+}
+
+// ApacheSparkCredentialDataSourceModel is the model for the data source
+type ApacheSparkCredentialDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	CredentialID types.Int64  `tfsdk:"credential_id"`
+	ProjectID    types.Int64  `tfsdk:"project_id"`
+	Schema       types.String `tfsdk:"schema"`
+	Cluster      types.String `tfsdk:"cluster"`
+	TargetName   types.String `tfsdk:"target_name"`
+	Threads      types.Int64  `tfsdk:"threads"`
+}
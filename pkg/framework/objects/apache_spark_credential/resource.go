@@ -0,0 +1,278 @@
+package apache_spark_credential
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apacheSparkCredentialResource{}
+	_ resource.ResourceWithConfigure   = &apacheSparkCredentialResource{}
+	_ resource.ResourceWithImportState = &apacheSparkCredentialResource{}
+)
+
+// ApacheSparkCredentialResource is a helper function to simplify the provider implementation.
+func ApacheSparkCredentialResource() resource.Resource {
+	return &apacheSparkCredentialResource{}
+}
+
+// apacheSparkCredentialResource is the resource implementation.
+type apacheSparkCredentialResource struct {
+	client *dbt_cloud.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apacheSparkCredentialResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *apacheSparkCredentialResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_apache_spark_credential"
+}
+
+// Schema defines the schema for the resource.
+func (r *apacheSparkCredentialResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apacheSparkCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Retrieve values from plan
+	var plan ApacheSparkCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+
+	// Create new credential
+	credential, err := r.client.CreateApacheSparkCredential(
+		ctx,
+		projectID,
+		plan.Token.ValueString(),
+		plan.Schema.ValueString(),
+		plan.Cluster.ValueString(),
+		plan.TargetName.ValueString(),
+		int(plan.Threads.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Apache Spark credential",
+			"Could not create Apache Spark credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate computed values
+	plan.ID = types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, *credential.ID))
+	plan.CredentialID = types.Int64Value(int64(*credential.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apacheSparkCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Get current state
+	var state ApacheSparkCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get credential from API
+	projectID := int(state.ProjectID.ValueInt64())
+	credentialID := int(state.CredentialID.ValueInt64())
+
+	credential, err := r.client.GetApacheSparkCredential(projectID, credentialID)
+	if err != nil {
+		if strings.Contains(err.Error(), "resource-not-found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading Apache Spark credential",
+			"Could not read Apache Spark credential ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Refresh state values, the token is not returned by the API
+	state.ID = types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, credentialID))
+	state.Schema = types.StringValue(credential.UnencryptedCredentialDetails.Schema)
+	state.Cluster = types.StringValue(credential.UnencryptedCredentialDetails.Cluster)
+	state.TargetName = types.StringValue(credential.TargetName)
+	state.Threads = types.Int64Value(int64(credential.Threads))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *apacheSparkCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Retrieve values from plan
+	var plan ApacheSparkCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state ApacheSparkCredentialResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	credentialID := int(state.CredentialID.ValueInt64())
+	threads := int(plan.Threads.ValueInt64())
+
+	// Generate credential details
+	credentialDetails, err := dbt_cloud.GenerateApacheSparkCredentialDetails(
+		plan.Token.ValueString(),
+		plan.Schema.ValueString(),
+		plan.Cluster.ValueString(),
+		threads,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Apache Spark credential",
+			"Could not generate credential details: "+err.Error(),
+		)
+		return
+	}
+
+	// Create update object
+	updateCredential := dbt_cloud.ApacheSparkCredentialRequest{
+		ID:                &credentialID,
+		AccountID:         r.client.AccountID,
+		ProjectID:         projectID,
+		Type:              "adapter",
+		State:             dbt_cloud.STATE_ACTIVE,
+		Threads:           threads,
+		TargetName:        plan.TargetName.ValueString(),
+		CredentialDetails: credentialDetails,
+		AdapterVersion:    dbt_cloud.ApacheSparkConfig{}.AdapterVersion(),
+	}
+
+	// Update credential
+	_, err = r.client.UpdateApacheSparkCredential(
+		projectID,
+		credentialID,
+		updateCredential,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Apache Spark credential",
+			"Could not update Apache Spark credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *apacheSparkCredentialResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Retrieve values from state
+	var state ApacheSparkCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credential
+	_, err := r.client.DeleteCredential(
+		strconv.Itoa(int(state.CredentialID.ValueInt64())),
+		strconv.Itoa(int(state.ProjectID.ValueInt64())),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Apache Spark credential",
+			"Could not delete Apache Spark credential, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// ImportState imports the resource into Terraform state, the other values are set by the following Read
+func (r *apacheSparkCredentialResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectID, credentialID, err := helper.SplitIDToInts(req.ID, "dbtcloud_apache_spark_credential")
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credential_id"), credentialID)...)
+}
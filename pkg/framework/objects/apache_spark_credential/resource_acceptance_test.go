@@ -0,0 +1,145 @@
+package apache_spark_credential_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudApacheSparkCredentialResource(t *testing.T) {
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	token := "test_token"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudApacheSparkCredentialDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDbtCloudApacheSparkCredentialResourceConfig(
+					projectName,
+					"test_schema",
+					token,
+					"",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDbtCloudApacheSparkCredentialExists("dbtcloud_apache_spark_credential.test"),
+					resource.TestCheckResourceAttrSet("dbtcloud_apache_spark_credential.test", "id"),
+					resource.TestCheckResourceAttrSet("dbtcloud_apache_spark_credential.test", "credential_id"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "schema", "test_schema"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "target_name", "default"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "threads", "4"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDbtCloudApacheSparkCredentialResourceConfig(
+					projectName,
+					"updated_schema",
+					token,
+					`cluster = "my-cluster"
+  target_name = "spark"
+  threads = 8`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDbtCloudApacheSparkCredentialExists("dbtcloud_apache_spark_credential.test"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "schema", "updated_schema"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "cluster", "my-cluster"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "target_name", "spark"),
+					resource.TestCheckResourceAttr("dbtcloud_apache_spark_credential.test", "threads", "8"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dbtcloud_apache_spark_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The token can't be read from the API
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudApacheSparkCredentialResourceConfig(
+	projectName string,
+	schema string,
+	token string,
+	extraConfig string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "%s"
+}
+
+resource "dbtcloud_apache_spark_credential" "test" {
+  project_id = dbtcloud_project.test.id
+  schema     = "%s"
+  token      = "%s"
+  %s
+}
+`, projectName, schema, token, extraConfig)
+}
+
+func testAccCheckDbtCloudApacheSparkCredentialExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		projectID, credentialID, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_apache_spark_credential")
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client")
+		}
+		_, err = apiClient.GetApacheSparkCredential(projectID, credentialID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudApacheSparkCredentialDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_apache_spark_credential" {
+			continue
+		}
+		projectID, credentialID, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_apache_spark_credential")
+		if err != nil {
+			return err
+		}
+
+		_, err = apiClient.GetApacheSparkCredential(projectID, credentialID)
+		if err == nil {
+			return fmt.Errorf("Apache Spark credential still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package apache_spark_credential
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var resourceSchema = resource_schema.Schema{
+	Description: "Apache Spark credential resource, to be used with a `dbtcloud_global_connection` configured with `apache_spark`",
+	Attributes: map[string]resource_schema.Attribute{
+		"id": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource. Contains the project ID and the credential ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": resource_schema.Int64Attribute{
+			Required:    true,
+			Description: "Project ID to create the Apache Spark credential in",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"credential_id": resource_schema.Int64Attribute{
+			Computed:    true,
+			Description: "The internal credential ID",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"token": resource_schema.StringAttribute{
+			Required:    true,
+			Sensitive:   true,
+			Description: "The token to connect to the Apache Spark cluster",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"schema": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "The schema where to create models",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"cluster": resource_schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "The ID of the cluster to connect to. Defaults to the cluster of the connection",
+		},
+		"target_name": resource_schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("default"),
+			Description: "Target name. Default is `default`",
+		},
+		"threads": resource_schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(4),
+			Description: "The number of threads to use. Default is 4",
+		},
+	},
+}
+
+var datasourceSchema = datasource_schema.Schema{
+	Description: "Apache Spark credential data source",
+	Attributes: map[string]datasource_schema.Attribute{
+		"id": datasource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource. Contains the project ID and the credential ID.",
+		},
+		"credential_id": datasource_schema.Int64Attribute{
+			Required:    true,
+			Description: "Credential ID",
+		},
+		"project_id": datasource_schema.Int64Attribute{
+			Required:    true,
+			Description: "Project ID",
+		},
+		"schema": datasource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The schema where to create models",
+		},
+		"cluster": datasource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the cluster to connect to, empty when using the cluster of the connection",
+		},
+		"target_name": datasource_schema.StringAttribute{
+			Computed:    true,
+			Description: "Target name",
+		},
+		"threads": datasource_schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of threads to use",
+		},
+	},
+}
@@ -0,0 +1,108 @@
+package connection_test_run
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultTimeoutSeconds = 120
+	pollInterval          = 5 * time.Second
+)
+
+var (
+	_ datasource.DataSource              = &connectionTestDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionTestDataSource{}
+)
+
+func ConnectionTestDataSource() datasource.DataSource {
+	return &connectionTestDataSource{}
+}
+
+type connectionTestDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *connectionTestDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_connection_test"
+}
+
+func (d *connectionTestDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state ConnectionTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutSeconds := int64(defaultTimeoutSeconds)
+	if !state.TimeoutSeconds.IsNull() {
+		timeoutSeconds = state.TimeoutSeconds.ValueInt64()
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+	environmentID := int(state.EnvironmentID.ValueInt64())
+
+	testRun, err := d.client.RunConnectionTest(
+		ctx,
+		projectID,
+		environmentID,
+		pollInterval,
+		time.Duration(timeoutSeconds)*time.Second,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error running the connection test",
+			fmt.Sprintf("Could not run the connection test for the environment %d: %s", environmentID, err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(testRun.ID)
+	state.Status = types.StringValue(testRun.Status)
+	state.Succeeded = types.BoolValue(testRun.Status == dbt_cloud.CONNECTION_TEST_STATUS_SUCCESS)
+	state.Message = types.StringValue(testRun.Message)
+
+	if !state.Succeeded.ValueBool() {
+		summary := "Connection test failed"
+		detail := fmt.Sprintf("The connection test for the environment %d failed:\n%s", environmentID, testRun.Message)
+		if state.FailOnError.IsNull() || state.FailOnError.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *connectionTestDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError(
+			"Missing client",
+			"A client is required to configure the connection test data source",
+		)
+	}
+}
@@ -0,0 +1,83 @@
+package connection_test_run_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudConnectionTestDataSource(t *testing.T) {
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the connection points to a Snowflake account that doesn't exist, so the test always fails
+			{
+				Config:      testAccDbtCloudConnectionTestDataSourceConfig(projectName, true),
+				ExpectError: regexp.MustCompile("Connection test failed"),
+			},
+			{
+				Config: testAccDbtCloudConnectionTestDataSourceConfig(projectName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_connection_test.test", "id"),
+					resource.TestCheckResourceAttr("data.dbtcloud_connection_test.test", "status", "error"),
+					resource.TestCheckResourceAttr("data.dbtcloud_connection_test.test", "succeeded", "false"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_connection_test.test", "message"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudConnectionTestDataSourceConfig(projectName string, failOnError bool) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_global_connection" "test" {
+  name = "%s connection"
+
+  snowflake = {
+    account   = "test"
+    role      = "role"
+    warehouse = "warehouse"
+    database  = "database"
+    allow_sso = false
+  }
+}
+
+resource "dbtcloud_snowflake_credential" "test" {
+  project_id  = dbtcloud_project.test_project.id
+  auth_type   = "password"
+  num_threads = 4
+  schema      = "analytics"
+  user        = "my_snowflake_user"
+  password    = "my_snowflake_password"
+}
+
+resource "dbtcloud_environment" "test" {
+  name            = "%s prod"
+  type            = "deployment"
+  dbt_version     = "%s"
+  project_id      = dbtcloud_project.test_project.id
+  deployment_type = "production"
+  connection_id   = dbtcloud_global_connection.test.id
+  credential_id   = dbtcloud_snowflake_credential.test.credential_id
+}
+
+data "dbtcloud_connection_test" "test" {
+  project_id      = dbtcloud_project.test_project.id
+  environment_id  = dbtcloud_environment.test.environment_id
+  timeout_seconds = 300
+  fail_on_error   = %t
+}
+`, projectName, projectName, projectName, acctest_config.DBT_CLOUD_VERSION, failOnError)
+}
@@ -0,0 +1,14 @@
+package connection_test_run
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ConnectionTestDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.Int64  `tfsdk:"project_id"`
+	EnvironmentID  types.Int64  `tfsdk:"environment_id"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	FailOnError    types.Bool   `tfsdk:"fail_on_error"`
+	Status         types.String `tfsdk:"status"`
+	Succeeded      types.Bool   `tfsdk:"succeeded"`
+	Message        types.String `tfsdk:"message"`
+}
@@ -0,0 +1,63 @@
+package connection_test_run
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (d *connectionTestDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: `Runs the dbt Cloud connection test of an environment, the same as the ` + "`Test connection`" + ` button of the environment settings, and waits for its result.
+
+~> A new connection test is started in dbt Cloud every time the data source is read, i.e. on every ` + "`terraform plan`" + `, ` + "`terraform apply`" + ` and refresh, and each run connects to the warehouse and can take up to ` + "`timeout_seconds`" + `. To only run it on demand, gate the data source behind a variable with ` + "`count`" + `, as in the example below.
+
+The test checks the global connection of the environment with its deployment credential. When the test fails, the error message from the adapter is raised as an error diagnostic, or as a warning when ` + "`fail_on_error`" + ` is set to ` + "`false`" + `.
+
+To test a connection after its credential is created or updated, reference the credential in ` + "`depends_on`" + ` so that the data source is read during the apply.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the connection test run",
+			},
+			"project_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the project of the environment",
+			},
+			"environment_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the environment whose connection and credential are tested",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds to wait for the test to complete before failing - Defaults to `120`",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether a failed connection test raises an error, otherwise a warning is raised and the result is available in `succeeded` and `message` - Defaults to `true`",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the connection test, `success` or `error`",
+			},
+			"succeeded": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the connection test succeeded",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The message returned by the connection test, with the error from the adapter when the test failed",
+			},
+		},
+	}
+}
@@ -0,0 +1,12 @@
+package credential
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type CredentialResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	CredentialID    types.Int64  `tfsdk:"credential_id"`
+	ProjectID       types.Int64  `tfsdk:"project_id"`
+	AdapterVersion  types.String `tfsdk:"adapter_version"`
+	Fields          types.Map    `tfsdk:"fields"`
+	SensitiveFields types.Map    `tfsdk:"sensitive_fields"`
+}
@@ -0,0 +1,350 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &credentialResource{}
+	_ resource.ResourceWithConfigure   = &credentialResource{}
+	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.ResourceWithModifyPlan  = &credentialResource{}
+)
+
+func CredentialResource() resource.Resource {
+	return &credentialResource{}
+}
+
+type credentialResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *credentialResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (r *credentialResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+// stringMap converts a map attribute to a Go map, known is false when the map or one of its values is not known yet
+func stringMap(ctx context.Context, value types.Map) (result map[string]string, known bool, diags diag.Diagnostics) {
+	result = map[string]string{}
+	if value.IsUnknown() {
+		return result, false, diags
+	}
+
+	elements := map[string]types.String{}
+	diags.Append(value.ElementsAs(ctx, &elements, false)...)
+	for key, element := range elements {
+		if element.IsUnknown() {
+			return result, false, diags
+		}
+		result[key] = element.ValueString()
+	}
+	return result, true, diags
+}
+
+// generateCredentialDetails retrieves the credential fields of the adapter version and fills them with the values of the plan
+func (r *credentialResource) generateCredentialDetails(
+	ctx context.Context,
+	plan CredentialResourceModel,
+) (dbt_cloud.AdapterCredentialDetails, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields, _, fieldsDiags := stringMap(ctx, plan.Fields)
+	diags.Append(fieldsDiags...)
+	sensitiveFields, _, sensitiveFieldsDiags := stringMap(ctx, plan.SensitiveFields)
+	diags.Append(sensitiveFieldsDiags...)
+	if diags.HasError() {
+		return dbt_cloud.AdapterCredentialDetails{}, diags
+	}
+
+	adapterVersion := plan.AdapterVersion.ValueString()
+	adapterFields, err := r.client.GetAdapterCredentialFields(adapterVersion)
+	if err != nil {
+		diags.AddError(
+			"Error retrieving the adapter credential fields",
+			fmt.Sprintf("Could not retrieve the credential fields of the adapter version %s: %s", adapterVersion, err),
+		)
+		return dbt_cloud.AdapterCredentialDetails{}, diags
+	}
+
+	credentialDetails, err := dbt_cloud.GenerateAdapterCredentialDetails(*adapterFields, fields, sensitiveFields)
+	if err != nil {
+		diags.AddError(
+			"Invalid credential fields",
+			fmt.Sprintf("The fields don't match the adapter version %s:\n%s", adapterVersion, err),
+		)
+	}
+	return credentialDetails, diags
+}
+
+// ModifyPlan validates the fields against the metadata of the adapter version, so that errors are raised during the plan
+func (r *credentialResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// nothing to check when the credential is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.AdapterVersion.IsUnknown() {
+		return
+	}
+
+	fields, fieldsKnown, diags := stringMap(ctx, plan.Fields)
+	resp.Diagnostics.Append(diags...)
+	sensitiveFields, sensitiveFieldsKnown, diags := stringMap(ctx, plan.SensitiveFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !fieldsKnown || !sensitiveFieldsKnown {
+		return
+	}
+
+	adapterVersion := plan.AdapterVersion.ValueString()
+	adapterFields, err := r.client.GetAdapterCredentialFields(adapterVersion)
+	if err != nil {
+		// the fields are validated again when the credential is created or updated
+		tflog.Warn(ctx, fmt.Sprintf("Could not retrieve the credential fields of the adapter version %s: %s", adapterVersion, err))
+		return
+	}
+
+	_, err = dbt_cloud.GenerateAdapterCredentialDetails(*adapterFields, fields, sensitiveFields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fields"),
+			"Invalid credential fields",
+			fmt.Sprintf("The fields don't match the adapter version %s:\n%s", adapterVersion, err),
+		)
+	}
+}
+
+func (r *credentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialDetails, diags := r.generateCredentialDetails(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	credential, err := r.client.CreateAdapterCredential(
+		projectID,
+		plan.AdapterVersion.ValueString(),
+		credentialDetails,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the credential",
+			"Could not create the credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, *credential.ID))
+	plan.CredentialID = types.Int64Value(int64(*credential.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// adapterFieldString returns the value of a credential field the way it is set in the `fields` map
+func adapterFieldString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (r *credentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+	credentialID := int(state.CredentialID.ValueInt64())
+
+	credential, err := r.client.GetAdapterCredential(projectID, credentialID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The credential was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading the credential",
+			"Could not read the credential "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.AdapterVersion = types.StringValue(credential.AdapterVersion)
+
+	// only the fields managed in the config are refreshed, unless the credential was just imported
+	stateFields, _, diags := stringMap(ctx, state.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	refreshAllFields, diags := helper.IsImported(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := map[string]string{}
+	for key, field := range credential.CredentialDetails.Fields {
+		if field.Metadata.Encrypt {
+			continue
+		}
+		if _, ok := stateFields[key]; ok {
+			fields[key] = adapterFieldString(field.Value)
+		} else if refreshAllFields && field.Metadata.Field_Type != "hidden" && adapterFieldString(field.Value) != "" {
+			fields[key] = adapterFieldString(field.Value)
+		}
+	}
+
+	if len(fields) > 0 || !state.Fields.IsNull() {
+		fieldsValue, diags := types.MapValueFrom(ctx, types.StringType, fields)
+		resp.Diagnostics.Append(diags...)
+		state.Fields = fieldsValue
+	}
+
+	resp.Diagnostics.Append(helper.ClearImported(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *credentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialDetails, diags := r.generateCredentialDetails(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAdapterCredential(
+		int(state.ProjectID.ValueInt64()),
+		int(state.CredentialID.ValueInt64()),
+		plan.AdapterVersion.ValueString(),
+		credentialDetails,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating the credential",
+			"Could not update the credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *credentialResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteCredential(
+		strconv.Itoa(int(state.CredentialID.ValueInt64())),
+		strconv.Itoa(int(state.ProjectID.ValueInt64())),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting the credential",
+			"Could not delete the credential, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *credentialResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectID, credentialID, err := helper.SplitIDToInts(req.ID, "dbtcloud_credential")
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credential_id"), credentialID)...)
+	resp.Diagnostics.Append(helper.SetImported(ctx, resp.Private)...)
+}
+
+func (r *credentialResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,146 @@
+package credential_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudCredentialResource(t *testing.T) {
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudCredentialDestroy,
+		Steps: []resource.TestStep{
+			// the fields are validated against the adapter during the plan
+			{
+				Config: testAccDbtCloudCredentialResourceConfig(
+					projectName,
+					`schema = "test_schema"
+    unknown_field = "value"`,
+				),
+				ExpectError: regexp.MustCompile(`the field "unknown_field" is not a credential field of the adapter`),
+			},
+			{
+				Config: testAccDbtCloudCredentialResourceConfig(
+					projectName,
+					`schema = "test_schema"
+    password = "test_password"`,
+				),
+				ExpectError: regexp.MustCompile(`the field "password" is encrypted and must be set in sensitive_fields`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDbtCloudCredentialResourceConfig(projectName, `schema = "test_schema"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDbtCloudCredentialExists("dbtcloud_credential.test"),
+					resource.TestCheckResourceAttrSet("dbtcloud_credential.test", "credential_id"),
+					resource.TestCheckResourceAttr("dbtcloud_credential.test", "adapter_version", "teradata_v0"),
+					resource.TestCheckResourceAttr("dbtcloud_credential.test", "fields.schema", "test_schema"),
+					resource.TestCheckResourceAttr("dbtcloud_credential.test", "fields.threads", "4"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDbtCloudCredentialResourceConfig(projectName, `schema = "updated_schema"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDbtCloudCredentialExists("dbtcloud_credential.test"),
+					resource.TestCheckResourceAttr("dbtcloud_credential.test", "fields.schema", "updated_schema"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dbtcloud_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the encrypted fields can't be read from the API
+				ImportStateVerifyIgnore: []string{"sensitive_fields"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudCredentialResourceConfig(projectName string, extraFields string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "%s"
+}
+
+resource "dbtcloud_credential" "test" {
+  project_id      = dbtcloud_project.test.id
+  adapter_version = "teradata_v0"
+  fields = {
+    user    = "test_user"
+    threads = "4"
+    %s
+  }
+  sensitive_fields = {
+    password = "test_password"
+  }
+}
+`, projectName, extraFields)
+}
+
+func testAccCheckDbtCloudCredentialExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		projectID, credentialID, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_credential")
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client")
+		}
+		_, err = apiClient.GetAdapterCredential(projectID, credentialID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudCredentialDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_credential" {
+			continue
+		}
+		projectID, credentialID, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_credential")
+		if err != nil {
+			return err
+		}
+
+		_, err = apiClient.GetAdapterCredential(projectID, credentialID)
+		if err == nil {
+			return fmt.Errorf("Credential still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
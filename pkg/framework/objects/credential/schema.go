@@ -0,0 +1,62 @@
+package credential
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var resourceSchema = schema.Schema{
+	Description: `Generic credential resource for any adapter supported by dbt Cloud.
+
+The credential fields are defined by the adapter version and are validated against its metadata during the plan. 
+This resource can be used for adapters that don't have a dedicated credential resource yet.`,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource. Contains the project ID and the credential ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"credential_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The internal credential ID",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "Project ID to create the credential in",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"adapter_version": schema.StringAttribute{
+			Required:    true,
+			Description: "The adapter version of the credential, e.g. `databricks_v0` or `teradata_v0`. It needs to match the adapter version of the connection of the environment using the credential",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"fields": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "The values of the credential fields that are not encrypted, e.g. `schema` or `threads`. Values are provided as strings and converted to the type of the field. Fields not set keep the default value of the adapter",
+		},
+		"sensitive_fields": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The values of the encrypted credential fields, e.g. `password` or `token`. Those values are not returned by the API and changes made outside of Terraform are not detected",
+		},
+	},
+}
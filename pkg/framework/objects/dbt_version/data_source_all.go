@@ -0,0 +1,80 @@
+package dbt_version
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dbtVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dbtVersionsDataSource{}
+)
+
+func DbtVersionsDataSource() datasource.DataSource {
+	return &dbtVersionsDataSource{}
+}
+
+type dbtVersionsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *dbtVersionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_versions"
+}
+
+func (d *dbtVersionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = allDatasourceSchema
+}
+
+func (d *dbtVersionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state DbtVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	versions, err := d.client.GetDbtVersions()
+	if err != nil {
+		resp.Diagnostics.AddError("Issue when retrieving the dbt versions", err.Error())
+		return
+	}
+
+	state.Versions = []DbtVersionDataSourceModel{}
+	for _, version := range versions {
+		state.Versions = append(state.Versions, DbtVersionDataSourceModel{
+			Version:        types.StringValue(version.Version),
+			Name:           types.StringValue(version.Name),
+			Description:    types.StringValue(version.Description),
+			IsReleaseTrack: types.BoolValue(version.IsReleaseTrack()),
+			IsDeprecated:   types.BoolValue(version.IsDeprecated),
+			EndOfLife:      types.StringPointerValue(version.EndOfLife),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *dbtVersionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,38 @@
+package dbt_version_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudDbtVersionsDataSource(t *testing.T) {
+
+	config := `
+data "dbtcloud_dbt_versions" "all" {
+}
+`
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbtcloud_dbt_versions.all", "versions.0.version"),
+		resource.TestCheckTypeSetElemNestedAttrs(
+			"data.dbtcloud_dbt_versions.all",
+			"versions.*",
+			map[string]string{
+				"version":          "latest",
+				"is_release_track": "true",
+			},
+		),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
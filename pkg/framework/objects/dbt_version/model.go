@@ -0,0 +1,18 @@
+package dbt_version
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DbtVersionDataSourceModel struct {
+	Version        types.String `tfsdk:"version"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	IsReleaseTrack types.Bool   `tfsdk:"is_release_track"`
+	IsDeprecated   types.Bool   `tfsdk:"is_deprecated"`
+	EndOfLife      types.String `tfsdk:"end_of_life"`
+}
+
+type DbtVersionsDataSourceModel struct {
+	Versions []DbtVersionDataSourceModel `tfsdk:"versions"`
+}
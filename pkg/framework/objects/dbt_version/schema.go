@@ -0,0 +1,43 @@
+package dbt_version
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var allDatasourceSchema = schema.Schema{
+	Description: "Retrieve the dbt versions and release tracks that can be used in the account, in the `dbt_version` of environments and jobs",
+	Attributes: map[string]schema.Attribute{
+		"versions": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The list of dbt versions and release tracks",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"version": schema.StringAttribute{
+						Computed:    true,
+						Description: "The value to use in `dbt_version`, e.g. `latest`, `compatible` or `1.7.0-latest`",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the version",
+					},
+					"description": schema.StringAttribute{
+						Computed:    true,
+						Description: "The description of the version",
+					},
+					"is_release_track": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the version is a release track (e.g. `latest`, `compatible`, `extended` or `latest-fusion`) rather than a version pinned to a dbt minor version",
+					},
+					"is_deprecated": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the version is deprecated",
+					},
+					"end_of_life": schema.StringAttribute{
+						Computed:    true,
+						Description: "The end of life date of the version, if any",
+					},
+				},
+			},
+		},
+	},
+}
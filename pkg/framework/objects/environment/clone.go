@@ -0,0 +1,81 @@
+package environment
+
+import (
+	"sort"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+)
+
+// newClonedJob returns the definition of the copy of a job of the source environment in the cloned environment.
+// Deferring to the source environment becomes deferring to the cloned environment.
+// References to other jobs of the source environment, for deferral or job completion triggers, are removed
+// as the copies of those jobs don't exist yet, and are set afterwards with remapClonedJob.
+func newClonedJob(source dbt_cloud.Job, sourceEnvironmentID int, environmentID int, sourceJobIDs map[int]bool) dbt_cloud.Job {
+	job := source
+	job.ID = nil
+	job.EnvironmentId = environmentID
+	job.State = dbt_cloud.STATE_ACTIVE
+	job.ExecuteSteps = append([]string{}, source.ExecuteSteps...)
+
+	if source.DeferringEnvironmentId != nil && *source.DeferringEnvironmentId == sourceEnvironmentID {
+		job.DeferringEnvironmentId = &environmentID
+	}
+	if source.DeferringJobId != nil && sourceJobIDs[*source.DeferringJobId] {
+		job.DeferringJobId = nil
+	}
+	if source.JobCompletionTrigger != nil && sourceJobIDs[source.JobCompletionTrigger.Condition.JobID] {
+		job.JobCompletionTrigger = nil
+	}
+
+	return job
+}
+
+// remapClonedJob sets on the copy of a job the references to the copies of the other jobs of the source environment.
+// It returns true when the copy needs to be updated.
+func remapClonedJob(job *dbt_cloud.Job, source dbt_cloud.Job, jobIDs map[int]int) bool {
+	updated := false
+
+	if source.DeferringJobId != nil {
+		if newJobID, ok := jobIDs[*source.DeferringJobId]; ok {
+			job.DeferringJobId = &newJobID
+			updated = true
+		}
+	}
+	if source.JobCompletionTrigger != nil {
+		if newJobID, ok := jobIDs[source.JobCompletionTrigger.Condition.JobID]; ok {
+			trigger := *source.JobCompletionTrigger
+			trigger.Condition.JobID = newJobID
+			trigger.Condition.Statuses = append([]int{}, source.JobCompletionTrigger.Condition.Statuses...)
+			job.JobCompletionTrigger = &trigger
+			updated = true
+		}
+	}
+
+	return updated
+}
+
+// environmentVariablesToClone returns the values of the environment variables set for the source environment, by variable name.
+// The values of secret environment variables are masked by the API and can't be copied, their names are returned separately.
+func environmentVariablesToClone(
+	environmentVariables map[string]map[string]dbt_cloud.EnvironmentVariableNameValue,
+	sourceEnvironmentName string,
+) (values map[string]string, skippedSecrets []string) {
+	values = map[string]string{}
+	skippedSecrets = []string{}
+
+	for name, environmentValues := range environmentVariables {
+		value, ok := environmentValues[sourceEnvironmentName]
+		if !ok || value.Value == "" {
+			continue
+		}
+		if helper.IsSecretEnvironmentVariable(name) {
+			skippedSecrets = append(skippedSecrets, name)
+			continue
+		}
+		values[name] = value.Value
+	}
+	sort.Strings(skippedSecrets)
+
+	return values, skippedSecrets
+}
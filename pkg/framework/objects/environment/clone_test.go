@@ -0,0 +1,92 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClonedJob(t *testing.T) {
+	sourceJobID, otherSourceJobID, externalJobID := 10, 11, 99
+	sourceEnvironmentID, otherEnvironmentID, environmentID := 1, 2, 3
+	sourceJobIDs := map[int]bool{sourceJobID: true, otherSourceJobID: true}
+
+	// deferring to the source environment and to a job of the source environment
+	source := dbt_cloud.Job{
+		ID:                     &sourceJobID,
+		EnvironmentId:          sourceEnvironmentID,
+		Name:                   "daily",
+		ExecuteSteps:           []string{"dbt build"},
+		DeferringEnvironmentId: &sourceEnvironmentID,
+		DeferringJobId:         &otherSourceJobID,
+		JobCompletionTrigger: &dbt_cloud.JobCompletionTrigger{
+			Condition: dbt_cloud.JobCompletionTriggerCondition{JobID: otherSourceJobID, Statuses: []int{10}},
+		},
+	}
+	job := newClonedJob(source, sourceEnvironmentID, environmentID, sourceJobIDs)
+	assert.Nil(t, job.ID)
+	assert.Equal(t, environmentID, job.EnvironmentId)
+	assert.Equal(t, "daily", job.Name)
+	assert.Equal(t, environmentID, *job.DeferringEnvironmentId)
+	assert.Nil(t, job.DeferringJobId)
+	assert.Nil(t, job.JobCompletionTrigger)
+
+	// references outside of the source environment are kept
+	source.DeferringEnvironmentId = &otherEnvironmentID
+	source.DeferringJobId = &externalJobID
+	source.JobCompletionTrigger.Condition.JobID = externalJobID
+	job = newClonedJob(source, sourceEnvironmentID, environmentID, sourceJobIDs)
+	assert.Equal(t, otherEnvironmentID, *job.DeferringEnvironmentId)
+	assert.Equal(t, externalJobID, *job.DeferringJobId)
+	assert.Equal(t, externalJobID, job.JobCompletionTrigger.Condition.JobID)
+}
+
+func TestRemapClonedJob(t *testing.T) {
+	sourceJobID, otherSourceJobID, externalJobID := 10, 11, 99
+	jobIDs := map[int]int{sourceJobID: 20, otherSourceJobID: 21}
+
+	source := dbt_cloud.Job{
+		ID:             &sourceJobID,
+		DeferringJobId: &sourceJobID,
+		JobCompletionTrigger: &dbt_cloud.JobCompletionTrigger{
+			Condition: dbt_cloud.JobCompletionTriggerCondition{JobID: otherSourceJobID, Statuses: []int{10, 20}},
+		},
+	}
+	job := dbt_cloud.Job{}
+	assert.True(t, remapClonedJob(&job, source, jobIDs))
+	assert.Equal(t, 20, *job.DeferringJobId)
+	assert.Equal(t, 21, job.JobCompletionTrigger.Condition.JobID)
+	assert.Equal(t, []int{10, 20}, job.JobCompletionTrigger.Condition.Statuses)
+	// the source job is not modified
+	assert.Equal(t, otherSourceJobID, source.JobCompletionTrigger.Condition.JobID)
+
+	// nothing to remap
+	source = dbt_cloud.Job{ID: &sourceJobID, DeferringJobId: &externalJobID}
+	job = dbt_cloud.Job{}
+	assert.False(t, remapClonedJob(&job, source, jobIDs))
+}
+
+func TestEnvironmentVariablesToClone(t *testing.T) {
+	values, skippedSecrets := environmentVariablesToClone(
+		map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{
+			"DBT_SET": {
+				"project": {ID: 1, Value: "default"},
+				"Prod":    {ID: 2, Value: "prod"},
+			},
+			"DBT_PROJECT_ONLY": {
+				"project": {ID: 3, Value: "default"},
+			},
+			"DBT_EMPTY": {
+				"Prod": {ID: 0, Value: ""},
+			},
+			"DBT_ENV_SECRET_TOKEN": {
+				"Prod": {ID: 4, Value: "*****"},
+			},
+		},
+		"Prod",
+	)
+
+	assert.Equal(t, map[string]string{"DBT_SET": "prod"}, values)
+	assert.Equal(t, []string{"DBT_ENV_SECRET_TOKEN"}, skippedSecrets)
+}
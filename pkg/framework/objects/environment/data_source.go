@@ -3,16 +3,21 @@ package environment
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
 var (
-	_ datasource.DataSource              = &environmentDataSource{}
-	_ datasource.DataSourceWithConfigure = &environmentDataSource{}
+	_ datasource.DataSource                   = &environmentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &environmentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &environmentDataSource{}
 )
 
 func EnvironmentDataSource() datasource.DataSource {
@@ -31,22 +36,111 @@ func (d *environmentDataSource) Metadata(
 	resp.TypeName = req.ProviderTypeName + "_environment"
 }
 
+func (d *environmentDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var data SingleEnvironmentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// values only known at apply time can't be checked yet
+	if data.EnvironmentID.IsUnknown() || data.Name.IsUnknown() || data.DeploymentType.IsUnknown() {
+		return
+	}
+
+	lookups := []bool{!data.EnvironmentID.IsNull(), !data.Name.IsNull(), !data.DeploymentType.IsNull()}
+	if lo.Count(lookups, true) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Invalid Attribute Configuration",
+			"Exactly one of environment_id, name or deployment_type must be configured.",
+		)
+	}
+}
+
+// findEnvironment returns the environment with the given name or deployment type, and an error if there is none or more than one
+func findEnvironment(
+	environments []dbt_cloud.Environment,
+	projectID int,
+	name string,
+	deploymentType string,
+) (*dbt_cloud.Environment, error) {
+	matchingEnvs := lo.Filter(environments, func(env dbt_cloud.Environment, _ int) bool {
+		if env.State == dbt_cloud.STATE_DELETED || env.Project_Id != projectID {
+			return false
+		}
+		if name != "" {
+			return env.Name == name
+		}
+		return env.DeploymentType != nil && *env.DeploymentType == deploymentType
+	})
+
+	lookup := fmt.Sprintf("named %q", name)
+	if name == "" {
+		lookup = fmt.Sprintf("with the deployment type %q", deploymentType)
+	}
+
+	switch len(matchingEnvs) {
+	case 0:
+		return nil, fmt.Errorf("no environment %s was found in project %d", lookup, projectID)
+	case 1:
+		return &matchingEnvs[0], nil
+	default:
+		envIDs := lo.Map(matchingEnvs, func(env dbt_cloud.Environment, _ int) string {
+			return strconv.Itoa(*env.ID)
+		})
+		return nil, fmt.Errorf(
+			"%d environments %s were found in project %d (environment IDs: %s), use environment_id to select one of them",
+			len(matchingEnvs),
+			lookup,
+			projectID,
+			strings.Join(envIDs, ", "),
+		)
+	}
+}
+
 func (d *environmentDataSource) Read(
 	ctx context.Context,
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var config EnvironmentDataSourceModel
+	var config SingleEnvironmentDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-	environment, err := d.client.GetEnvironment(
-		int(config.ProjectID.ValueInt64()),
-		int(config.EnvironmentID.ValueInt64()),
-	)
+	projectID := int(config.ProjectID.ValueInt64())
+	environmentID := int(config.EnvironmentID.ValueInt64())
+
+	if config.EnvironmentID.IsNull() {
+		environments, err := d.client.GetAllEnvironments(projectID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving the environments of the project", err.Error())
+			return
+		}
+
+		matchingEnv, err := findEnvironment(
+			environments,
+			projectID,
+			config.Name.ValueString(),
+			config.DeploymentType.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting the environment", err.Error())
+			return
+		}
+		environmentID = *matchingEnv.ID
+	}
+
+	environment, err := d.client.GetEnvironment(projectID, environmentID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			fmt.Sprintf("Did not find environment with this ID: %s", config.EnvironmentID),
+			fmt.Sprintf("Did not find environment with this ID: %d", environmentID),
 			err.Error(),
 		)
 		return
@@ -54,9 +148,14 @@ func (d *environmentDataSource) Read(
 
 	state := config
 
+	state.EnvironmentID = types.Int64Value(int64(environmentID))
 	state.CredentialsID = types.Int64PointerValue(
 		helper.IntPointerToInt64Pointer(environment.Credential_Id),
 	)
+	state.CredentialsType = types.StringValue("")
+	if environment.Credentials != nil {
+		state.CredentialsType = types.StringValue(environment.Credentials.Type)
+	}
 	state.Name = types.StringValue(environment.Name)
 	state.DbtVersion = types.StringValue(environment.Dbt_Version)
 	state.Type = types.StringValue(environment.Type)
@@ -66,6 +165,28 @@ func (d *environmentDataSource) Read(
 	state.ExtendedAttributesID = types.Int64PointerValue(
 		helper.IntPointerToInt64Pointer(environment.ExtendedAttributesID),
 	)
+	state.ConnectionID = types.Int64PointerValue(
+		helper.IntPointerToInt64Pointer(environment.ConnectionID),
+	)
+	state.ConnectionName = types.StringNull()
+	state.ConnectionAdapterVersion = types.StringNull()
+	if environment.ConnectionID != nil {
+		connection, err := d.client.GetGlobalConnectionAdapter(int64(*environment.ConnectionID))
+		if err != nil {
+			// the environment itself was found, so the connection details are left null instead of failing the read
+			resp.Diagnostics.AddWarning(
+				"Error getting the connection of the environment",
+				fmt.Sprintf(
+					"connection_name and connection_adapter_version are not set for the connection %d: %s",
+					*environment.ConnectionID,
+					err.Error(),
+				),
+			)
+		} else {
+			state.ConnectionName = types.StringValue(connection.Data.Name)
+			state.ConnectionAdapterVersion = types.StringValue(connection.Data.AdapterVersion)
+		}
+	}
 	state.EnableModelQueryHistory = types.BoolValue(environment.EnableModelQueryHistory)
 
 	diags := resp.State.Set(ctx, &state)
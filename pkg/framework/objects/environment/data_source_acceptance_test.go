@@ -39,6 +39,28 @@ func TestAccDbtCloudEnvironmentDataSource(t *testing.T) {
 			"enable_model_query_history",
 			"true",
 		),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_environment.test_by_name",
+			"environment_id",
+			"dbtcloud_environment.test_environment",
+			"environment_id",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment.test_by_name",
+			"custom_branch",
+			"customBranchName",
+		),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_environment.test_by_deployment_type",
+			"environment_id",
+			"dbtcloud_environment.test_environment_prod",
+			"environment_id",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment.test_by_deployment_type",
+			"deployment_type",
+			"production",
+		),
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -68,9 +90,29 @@ func environment(projectName, environmentName string) string {
 		enable_model_query_history = true
     }
 
+    resource "dbtcloud_environment" "test_environment_prod" {
+        project_id = dbtcloud_project.test_project.id
+        name = "%s_prod"
+        dbt_version = "%s"
+        type = "deployment"
+        deployment_type = "production"
+    }
+
     data "dbtcloud_environment" "test" {
         project_id = dbtcloud_project.test_project.id
         environment_id = dbtcloud_environment.test_environment.environment_id
     }
-    `, projectName, environmentName, acctest_config.AcceptanceTestConfig.DbtCloudVersion)
+
+    data "dbtcloud_environment" "test_by_name" {
+        project_id = dbtcloud_project.test_project.id
+        name = dbtcloud_environment.test_environment.name
+    }
+
+    data "dbtcloud_environment" "test_by_deployment_type" {
+        project_id = dbtcloud_project.test_project.id
+        deployment_type = "production"
+        depends_on = [dbtcloud_environment.test_environment_prod]
+    }
+    `, projectName, environmentName, acctest_config.AcceptanceTestConfig.DbtCloudVersion,
+		environmentName, acctest_config.AcceptanceTestConfig.DbtCloudVersion)
 }
@@ -0,0 +1,47 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindEnvironment(t *testing.T) {
+	production, staging := "production", "staging"
+	devID, prodID, stagingID, otherStagingID, deletedID, otherProjectID := 1, 2, 3, 4, 5, 6
+
+	environments := []dbt_cloud.Environment{
+		{ID: &devID, Project_Id: 10, Name: "Development", State: dbt_cloud.STATE_ACTIVE},
+		{ID: &prodID, Project_Id: 10, Name: "Production", DeploymentType: &production, State: dbt_cloud.STATE_ACTIVE},
+		{ID: &stagingID, Project_Id: 10, Name: "Staging", DeploymentType: &staging, State: dbt_cloud.STATE_ACTIVE},
+		{ID: &otherStagingID, Project_Id: 10, Name: "Staging", State: dbt_cloud.STATE_ACTIVE},
+		{ID: &deletedID, Project_Id: 10, Name: "Development", State: dbt_cloud.STATE_DELETED},
+		{ID: &otherProjectID, Project_Id: 20, Name: "Production", DeploymentType: &production, State: dbt_cloud.STATE_ACTIVE},
+	}
+
+	env, err := findEnvironment(environments, 10, "Development", "")
+	assert.NoError(t, err)
+	assert.Equal(t, devID, *env.ID)
+
+	env, err = findEnvironment(environments, 10, "", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, prodID, *env.ID)
+
+	env, err = findEnvironment(environments, 20, "", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, otherProjectID, *env.ID)
+
+	_, err = findEnvironment(environments, 10, "QA", "")
+	assert.EqualError(t, err, `no environment named "QA" was found in project 10`)
+
+	_, err = findEnvironment(environments, 20, "", "staging")
+	assert.EqualError(t, err, `no environment with the deployment type "staging" was found in project 20`)
+
+	_, err = findEnvironment(environments, 10, "Staging", "")
+	assert.EqualError(
+		t,
+		err,
+		`2 environments named "Staging" were found in project 10 (environment IDs: 3, 4), use environment_id to select one of them`,
+	)
+}
@@ -0,0 +1,78 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// findDeprecatedDbtVersion returns the dbt version matching `version` when it is a deprecated pinned version, nil otherwise
+func findDeprecatedDbtVersion(versions []dbt_cloud.DbtVersion, version string) *dbt_cloud.DbtVersion {
+	for _, dbtVersion := range versions {
+		if dbtVersion.Version == version && dbtVersion.IsDeprecated && !dbtVersion.IsReleaseTrack() {
+			return &dbtVersion
+		}
+	}
+	return nil
+}
+
+// ModifyPlan warns when the environment is pinned to a deprecated dbt version,
+// unless the warnings are disabled with `disable_deprecation_warnings` in the provider
+func (r *environmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// nothing to check when the environment is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// the provider might not be configured yet, e.g. when its config depends on values only known at apply time
+	if r.client == nil || r.client.DisableDeprecationWarnings {
+		return
+	}
+
+	var dbtVersion types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("dbt_version"), &dbtVersion)...)
+	if resp.Diagnostics.HasError() || dbtVersion.IsNull() || dbtVersion.IsUnknown() {
+		return
+	}
+
+	// release tracks are never deprecated, no need to call the API for them
+	if (dbt_cloud.DbtVersion{Version: dbtVersion.ValueString()}).IsReleaseTrack() {
+		return
+	}
+
+	versions, err := r.client.GetDbtVersions()
+	if err != nil {
+		// the check is only informative and should not prevent planning
+		tflog.Warn(ctx, fmt.Sprintf("Could not retrieve the dbt versions: %s", err.Error()))
+		return
+	}
+
+	deprecatedVersion := findDeprecatedDbtVersion(versions, dbtVersion.ValueString())
+	if deprecatedVersion == nil {
+		return
+	}
+
+	endOfLife := ""
+	if deprecatedVersion.EndOfLife != nil {
+		endOfLife = fmt.Sprintf(" and reaches its end of life on %s", *deprecatedVersion.EndOfLife)
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("dbt_version"),
+		"Deprecated dbt version",
+		fmt.Sprintf(
+			"The dbt version %s is deprecated%s. Consider moving the environment to a release track like `latest` or `compatible`, "+
+				"the list of available versions can be retrieved with the `dbtcloud_dbt_versions` data source.",
+			dbtVersion.ValueString(),
+			endOfLife,
+		),
+	)
+}
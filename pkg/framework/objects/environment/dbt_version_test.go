@@ -0,0 +1,27 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDeprecatedDbtVersion(t *testing.T) {
+	endOfLife := "2025-04-30"
+	versions := []dbt_cloud.DbtVersion{
+		{Version: "latest", Name: "Latest"},
+		{Version: "compatible", Name: "Compatible", IsDeprecated: true},
+		{Version: "1.9.0-latest", Name: "1.9"},
+		{Version: "1.7.0-latest", Name: "1.7", IsDeprecated: true, EndOfLife: &endOfLife},
+	}
+
+	deprecated := findDeprecatedDbtVersion(versions, "1.7.0-latest")
+	assert.NotNil(t, deprecated)
+	assert.Equal(t, "2025-04-30", *deprecated.EndOfLife)
+
+	assert.Nil(t, findDeprecatedDbtVersion(versions, "1.9.0-latest"))
+	assert.Nil(t, findDeprecatedDbtVersion(versions, "1.5.0-latest"))
+	// release tracks are not reported even if flagged as deprecated
+	assert.Nil(t, findDeprecatedDbtVersion(versions, "compatible"))
+}
@@ -17,6 +17,24 @@ type EnvironmentDataSourceModel struct {
 	EnableModelQueryHistory types.Bool   `tfsdk:"enable_model_query_history"`
 }
 
+type SingleEnvironmentDataSourceModel struct {
+	EnvironmentID            types.Int64  `tfsdk:"environment_id"`
+	ProjectID                types.Int64  `tfsdk:"project_id"`
+	CredentialsID            types.Int64  `tfsdk:"credentials_id"`
+	CredentialsType          types.String `tfsdk:"credentials_type"`
+	Name                     types.String `tfsdk:"name"`
+	DbtVersion               types.String `tfsdk:"dbt_version"`
+	Type                     types.String `tfsdk:"type"`
+	UseCustomBranch          types.Bool   `tfsdk:"use_custom_branch"`
+	CustomBranch             types.String `tfsdk:"custom_branch"`
+	DeploymentType           types.String `tfsdk:"deployment_type"`
+	ExtendedAttributesID     types.Int64  `tfsdk:"extended_attributes_id"`
+	ConnectionID             types.Int64  `tfsdk:"connection_id"`
+	ConnectionName           types.String `tfsdk:"connection_name"`
+	ConnectionAdapterVersion types.String `tfsdk:"connection_adapter_version"`
+	EnableModelQueryHistory  types.Bool   `tfsdk:"enable_model_query_history"`
+}
+
 type EnvironmentsDataSourceModel struct {
 	ProjectID    types.Int64                  `tfsdk:"project_id"`
 	Environments []EnvironmentDataSourceModel `tfsdk:"environments"`
@@ -38,3 +56,19 @@ type EnvironmentResourceModel struct {
 	ConnectionID            types.Int64  `tfsdk:"connection_id"`
 	EnableModelQueryHistory types.Bool   `tfsdk:"enable_model_query_history"`
 }
+
+type EnvironmentCloneResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	EnvironmentID            types.Int64  `tfsdk:"environment_id"`
+	ProjectID                types.Int64  `tfsdk:"project_id"`
+	SourceEnvironmentID      types.Int64  `tfsdk:"source_environment_id"`
+	Name                     types.String `tfsdk:"name"`
+	CredentialID             types.Int64  `tfsdk:"credential_id"`
+	DeploymentType           types.String `tfsdk:"deployment_type"`
+	CopyExtendedAttributes   types.Bool   `tfsdk:"copy_extended_attributes"`
+	CopyEnvironmentVariables types.Bool   `tfsdk:"copy_environment_variables"`
+	CopyJobs                 types.Bool   `tfsdk:"copy_jobs"`
+	DbtVersion               types.String `tfsdk:"dbt_version"`
+	ExtendedAttributesID     types.Int64  `tfsdk:"extended_attributes_id"`
+	JobIDs                   types.Map    `tfsdk:"job_ids"`
+}
@@ -17,6 +17,7 @@ var (
 	_ resource.Resource                = &environmentResource{}
 	_ resource.ResourceWithConfigure   = &environmentResource{}
 	_ resource.ResourceWithImportState = &environmentResource{}
+	_ resource.ResourceWithModifyPlan  = &environmentResource{}
 )
 
 func EnvironmentResource() resource.Resource {
@@ -0,0 +1,394 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &environmentCloneResource{}
+	_ resource.ResourceWithConfigure = &environmentCloneResource{}
+)
+
+func EnvironmentCloneResource() resource.Resource {
+	return &environmentCloneResource{}
+}
+
+type environmentCloneResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *environmentCloneResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment_clone"
+}
+
+func (r *environmentCloneResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+func (r *environmentCloneResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan EnvironmentCloneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	sourceEnvironmentID := int(plan.SourceEnvironmentID.ValueInt64())
+
+	source, err := r.client.GetEnvironment(projectID, sourceEnvironmentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the source environment", err.Error())
+		return
+	}
+
+	extendedAttributesID := 0
+	if plan.CopyExtendedAttributes.ValueBool() && source.ExtendedAttributesID != nil {
+		sourceExtendedAttributes, err := r.client.GetExtendedAttributes(projectID, *source.ExtendedAttributesID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting the extended attributes of the source environment", err.Error())
+			return
+		}
+
+		extendedAttributes, err := r.client.CreateExtendedAttributes(
+			dbt_cloud.STATE_ACTIVE,
+			projectID,
+			sourceExtendedAttributes.ExtendedAttributes,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error copying the extended attributes of the source environment", err.Error())
+			return
+		}
+		extendedAttributesID = *extendedAttributes.ID
+	}
+
+	customBranch := ""
+	if source.Custom_Branch != nil {
+		customBranch = *source.Custom_Branch
+	}
+	connectionID := 0
+	if source.ConnectionID != nil {
+		connectionID = *source.ConnectionID
+	}
+
+	environment, err := r.client.CreateEnvironment(
+		true,
+		projectID,
+		plan.Name.ValueString(),
+		source.Dbt_Version,
+		source.Type,
+		source.Use_Custom_Branch,
+		customBranch,
+		int(plan.CredentialID.ValueInt64()),
+		plan.DeploymentType.ValueString(),
+		extendedAttributesID,
+		connectionID,
+		source.EnableModelQueryHistory,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the cloned environment", err.Error())
+		return
+	}
+
+	environmentID := *environment.ID
+	plan.ID = types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, environmentID))
+	plan.EnvironmentID = types.Int64Value(int64(environmentID))
+	plan.DbtVersion = types.StringValue(environment.Dbt_Version)
+	plan.ExtendedAttributesID = types.Int64Null()
+	if extendedAttributesID != 0 {
+		plan.ExtendedAttributesID = types.Int64Value(int64(extendedAttributesID))
+	}
+	plan.JobIDs = types.MapValueMust(types.Int64Type, nil)
+
+	// the environment exists from here, so it is saved in the state even if copying the variables or the jobs fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CopyEnvironmentVariables.ValueBool() {
+		resp.Diagnostics.Append(r.copyEnvironmentVariables(projectID, source.Name, environment.Name)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.CopyJobs.ValueBool() {
+		jobIDs, diags := r.copyJobs(sourceEnvironmentID, environmentID)
+		resp.Diagnostics.Append(diags...)
+
+		// the jobs created before an error are kept in the state to be deleted with the environment
+		jobIDsMap, mapDiags := types.MapValueFrom(ctx, types.Int64Type, jobIDs)
+		resp.Diagnostics.Append(mapDiags...)
+		plan.JobIDs = jobIDsMap
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}
+}
+
+// copyEnvironmentVariables sets for the cloned environment the environment variable values of the source environment
+func (r *environmentCloneResource) copyEnvironmentVariables(
+	projectID int,
+	sourceEnvironmentName string,
+	environmentName string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	environmentVariables, err := r.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		diags.AddError("Error getting the environment variables of the project", err.Error())
+		return diags
+	}
+
+	values, skippedSecrets := environmentVariablesToClone(environmentVariables, sourceEnvironmentName)
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, err := r.client.UpdateEnvironmentVariable(
+			projectID,
+			dbt_cloud.AbstractedEnvironmentVariable{
+				Name:              name,
+				ProjectID:         projectID,
+				EnvironmentValues: map[string]string{environmentName: values[name]},
+			},
+		)
+		if err != nil {
+			diags.AddError(
+				"Error copying an environment variable value",
+				fmt.Sprintf("Could not set the value of %s for the cloned environment: %s", name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	if len(skippedSecrets) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("copy_environment_variables"),
+			"Secret environment variables not copied",
+			fmt.Sprintf(
+				"The values of %s are masked by dbt Cloud and couldn't be copied to the cloned environment, they need to be set separately.",
+				strings.Join(skippedSecrets, ", "),
+			),
+		)
+	}
+
+	return diags
+}
+
+// copyJobs copies the jobs of the source environment to the cloned environment and returns the mapping from the source job IDs to the copies
+func (r *environmentCloneResource) copyJobs(
+	sourceEnvironmentID int,
+	environmentID int,
+) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	jobIDsByString := map[string]int64{}
+
+	sourceJobs, err := r.client.GetAllJobs(0, sourceEnvironmentID)
+	if err != nil {
+		diags.AddError("Error getting the jobs of the source environment", err.Error())
+		return jobIDsByString, diags
+	}
+	sort.Slice(sourceJobs, func(i, j int) bool {
+		return *sourceJobs[i].ID < *sourceJobs[j].ID
+	})
+
+	sourceJobIDs := map[int]bool{}
+	for _, sourceJob := range sourceJobs {
+		sourceJobIDs[*sourceJob.ID] = true
+	}
+
+	jobIDs := map[int]int{}
+	jobs := map[int]*dbt_cloud.Job{}
+	for _, sourceJob := range sourceJobs {
+		job, err := r.client.CreateJobFromDefinition(
+			newClonedJob(sourceJob.Job, sourceEnvironmentID, environmentID, sourceJobIDs),
+		)
+		if err != nil {
+			diags.AddError(
+				"Error copying a job",
+				fmt.Sprintf("Could not copy the job %d: %s", *sourceJob.ID, err.Error()),
+			)
+			return jobIDsByString, diags
+		}
+		jobIDs[*sourceJob.ID] = *job.ID
+		jobs[*sourceJob.ID] = job
+		jobIDsByString[strconv.Itoa(*sourceJob.ID)] = int64(*job.ID)
+	}
+
+	// the references between jobs can only be set once all of them are copied
+	for _, sourceJob := range sourceJobs {
+		job := jobs[*sourceJob.ID]
+		if !remapClonedJob(job, sourceJob.Job, jobIDs) {
+			continue
+		}
+
+		_, err := r.client.UpdateJob(strconv.Itoa(*job.ID), *job)
+		if err != nil {
+			diags.AddError(
+				"Error updating a copied job",
+				fmt.Sprintf("Could not set the deferral and triggers of the copy of the job %d: %s", *sourceJob.ID, err.Error()),
+			)
+			return jobIDsByString, diags
+		}
+	}
+
+	return jobIDsByString, diags
+}
+
+func (r *environmentCloneResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state EnvironmentCloneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(
+		int(state.ProjectID.ValueInt64()),
+		int(state.EnvironmentID.ValueInt64()),
+	)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The cloned environment was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the cloned environment", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(environment.Name)
+	state.DbtVersion = types.StringValue(environment.Dbt_Version)
+	if !state.CredentialID.IsNull() {
+		state.CredentialID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(environment.Credential_Id))
+	}
+	state.DeploymentType = types.StringPointerValue(environment.DeploymentType)
+	state.ExtendedAttributesID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(environment.ExtendedAttributesID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *environmentCloneResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan EnvironmentCloneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	environmentID := int(plan.EnvironmentID.ValueInt64())
+
+	environment, err := r.client.GetEnvironment(projectID, environmentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the cloned environment", err.Error())
+		return
+	}
+
+	environment.Name = plan.Name.ValueString()
+	environment.Credential_Id = nil
+	if !plan.CredentialID.IsNull() {
+		credentialID := int(plan.CredentialID.ValueInt64())
+		environment.Credential_Id = &credentialID
+	}
+
+	_, err = r.client.UpdateEnvironment(projectID, environmentID, *environment)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating the cloned environment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *environmentCloneResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state EnvironmentCloneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+
+	jobIDs := map[string]int64{}
+	resp.Diagnostics.Append(state.JobIDs.ElementsAs(ctx, &jobIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, jobID := range jobIDs {
+		jobIDStr := strconv.FormatInt(jobID, 10)
+		job, err := r.client.GetJob(jobIDStr)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "resource-not-found") {
+				continue
+			}
+			resp.Diagnostics.AddError("Error getting a copied job", err.Error())
+			return
+		}
+
+		job.State = dbt_cloud.STATE_DELETED
+		_, err = r.client.UpdateJob(jobIDStr, *job)
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting a copied job", err.Error())
+			return
+		}
+	}
+
+	_, err := r.client.DeleteEnvironment(projectID, int(state.EnvironmentID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the cloned environment", err.Error())
+		return
+	}
+
+	if state.CopyExtendedAttributes.ValueBool() && !state.ExtendedAttributesID.IsNull() {
+		_, err := r.client.DeleteExtendedAttributes(projectID, int(state.ExtendedAttributesID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting the copied extended attributes", err.Error())
+			return
+		}
+	}
+}
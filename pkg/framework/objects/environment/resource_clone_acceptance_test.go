@@ -0,0 +1,125 @@
+package environment_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudEnvironmentCloneResource(t *testing.T) {
+
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	cloneName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	cloneNameRenamed := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentCloneResourceConfig(projectName, environmentName, cloneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_clone.test_clone", "name", cloneName),
+					resource.TestCheckResourceAttrSet("dbtcloud_environment_clone.test_clone", "environment_id"),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_clone.test_clone",
+						"dbt_version",
+						acctest_config.DBT_CLOUD_VERSION,
+					),
+					resource.TestCheckResourceAttr("dbtcloud_environment_clone.test_clone", "job_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_environment_clone.test_clone",
+						"extended_attributes_id",
+					),
+				),
+			},
+			// renaming the clone doesn't recreate it
+			{
+				Config: testAccDbtCloudEnvironmentCloneResourceConfig(projectName, environmentName, cloneNameRenamed),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_clone.test_clone", "name", cloneNameRenamed),
+					resource.TestCheckResourceAttr("dbtcloud_environment_clone.test_clone", "job_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentCloneResourceConfig(projectName, environmentName, cloneName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_extended_attributes" "test_extended_attributes" {
+  project_id = dbtcloud_project.test_project.id
+  extended_attributes = jsonencode({
+    type = "databricks"
+  })
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name                   = "%s"
+  type                   = "deployment"
+  dbt_version            = "%s"
+  project_id             = dbtcloud_project.test_project.id
+  extended_attributes_id = dbtcloud_extended_attributes.test_extended_attributes.extended_attributes_id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name       = "DBT_CLONED_VAR"
+  project_id = dbtcloud_project.test_project.id
+  environment_values = {
+    "project" : "default",
+    (dbtcloud_environment.test_env.name) : "source_value"
+  }
+}
+
+resource "dbtcloud_job" "test_job" {
+  name           = "daily"
+  project_id     = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_env.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+}
+
+resource "dbtcloud_job" "test_job_after" {
+  name           = "after daily"
+  project_id     = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_env.environment_id
+  execute_steps  = ["dbt test"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+  job_completion_trigger_condition {
+    job_id     = dbtcloud_job.test_job.id
+    project_id = dbtcloud_project.test_project.id
+    statuses   = ["success"]
+  }
+}
+
+resource "dbtcloud_environment_clone" "test_clone" {
+  project_id                 = dbtcloud_project.test_project.id
+  source_environment_id      = dbtcloud_environment.test_env.environment_id
+  name                       = "%s"
+  copy_extended_attributes   = true
+  copy_environment_variables = true
+  copy_jobs                  = true
+  depends_on = [
+    dbtcloud_environment_variable.test_env_var,
+    dbtcloud_job.test_job_after,
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, cloneName)
+}
@@ -10,12 +10,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func (r *environmentDataSource) Schema(
@@ -24,11 +27,12 @@ func (r *environmentDataSource) Schema(
 	resp *datasource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieve data for a single environment",
+		Description: "Retrieve data for a single environment. The environment can be looked up by `environment_id`, or by `name` or `deployment_type` within the project.",
 		Attributes: map[string]schema.Attribute{
 			"environment_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "The ID of the environment",
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the environment. Exactly one of `environment_id`, `name` or `deployment_type` must be set",
 			},
 			"project_id": schema.Int64Attribute{
 				Required:    true,
@@ -38,9 +42,14 @@ func (r *environmentDataSource) Schema(
 				Computed:    true,
 				Description: "Credential ID for this environment. A credential is not required for development environments, as dbt Cloud defaults to the user's credentials, but deployment environments will have this.",
 			},
+			"credentials_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the credential of the environment (e.g. `snowflake`, `bigquery`, `databricks`), empty when the environment doesn't have a credential",
+			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The name of the environment",
+				Description: "The name of the environment. Used to look up the environment in the project when `environment_id` is not set",
 			},
 			"dbt_version": schema.StringAttribute{
 				Computed:    true,
@@ -59,8 +68,12 @@ func (r *environmentDataSource) Schema(
 				Description: "The custom branch name to use",
 			},
 			"deployment_type": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The type of deployment environment (currently 'production', 'staging' or empty)",
+				Description: "The type of deployment environment (currently 'production', 'staging' or empty). Used to look up the environment in the project when `environment_id` is not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "staging"),
+				},
 			},
 			"extended_attributes_id": schema.Int64Attribute{
 				Computed:    true,
@@ -74,6 +87,14 @@ func (r *environmentDataSource) Schema(
 					"When configuring this field, it needs to be configured for all the environments of the project. " +
 					"To avoid Terraform state issues, when using this field, the `dbtcloud_project_connection` resource should be removed from the project or you need to make sure that the `connection_id` is the same in `dbtcloud_project_connection` and in the `connection_id` of the Development environment of the project",
 			},
+			"connection_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the connection used by the environment (null if the connection can't be read)",
+			},
+			"connection_adapter_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The adapter version of the connection used by the environment (e.g. `snowflake_v0`) (null if the connection can't be read)",
+			},
 			"enable_model_query_history": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether model query history is on",
@@ -206,7 +227,7 @@ func (r *environmentResource) Schema(
 				Computed:    true,
 				Optional:    true,
 				Default:     stringdefault.StaticString("latest"),
-				Description: "Version number of dbt to use in this environment. It needs to be in the format `major.minor.0-latest` (e.g. `1.5.0-latest`), `major.minor.0-pre`, `compatible`, `extended`, `versionless`, `latest` or `latest-fusion`. While `versionless` is still supported, using `latest` is recommended. Defaults to `latest` if no version is provided",
+				Description: "Version number of dbt to use in this environment. It needs to be in the format `major.minor.0-latest` (e.g. `1.5.0-latest`), `major.minor.0-pre`, `compatible`, `extended`, `versionless`, `latest` or `latest-fusion`. While `versionless` is still supported, using `latest` is recommended. Defaults to `latest` if no version is provided. The available versions can be retrieved with the `dbtcloud_dbt_versions` data source and a warning is raised when the version is deprecated (unless `disable_deprecation_warnings` is set in the provider)",
 				Validators: []validator.String{
 					helper.DbtVersionValidator{}, // Custom validator to check the dbt version format
 				},
@@ -258,3 +279,116 @@ func (r *environmentResource) Schema(
 		},
 	}
 }
+
+func (r *environmentCloneResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: helper.DocString(
+			`Create a new environment as a clone of an existing one, with the same dbt version, custom branch and connection,
+			and optionally copies of its extended attributes, environment variable values and jobs.
+
+			The settings are only copied when the clone is created, changes to the source environment are not propagated afterwards.
+			Credentials store secrets that can't be read from dbt Cloud, so they are not copied and need to be set with ~~~credential_id~~~.`,
+		),
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the cloned environment, in the format `project_id:environment_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the cloned environment",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID of the source environment, the clone is created in the same project",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source_environment_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the environment to clone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cloned environment",
+			},
+			"credential_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the credential to use for the cloned environment",
+			},
+			"deployment_type": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "The deployment type of the cloned environment, 'production' or 'staging'. It is not copied from the source environment as a project can only have one environment of each of those types. Leave empty for a generic environment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("production", "staging"),
+				},
+			},
+			"copy_extended_attributes": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to create a copy of the extended attributes of the source environment for the clone - Defaults to `false`",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"copy_environment_variables": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to set for the clone the environment variable values of the source environment - Defaults to `false`. The values of secret environment variables are masked by dbt Cloud and can't be copied",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"copy_jobs": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to copy the jobs of the source environment to the clone - Defaults to `false`. Deferral to the source environment and references between its jobs, for deferral and job completion triggers, are remapped to the clone and its jobs. The copied jobs are deleted with the clone",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"dbt_version": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of dbt of the cloned environment, copied from the source environment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extended_attributes_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the extended attributes of the cloned environment, when they are copied",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_ids": resource_schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Map from the IDs of the jobs of the source environment to the IDs of their copies in the clone, when the jobs are copied",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,178 @@
+package environment_variable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &environmentVariablesDataSource{}
+	_ datasource.DataSourceWithConfigure = &environmentVariablesDataSource{}
+)
+
+// EnvironmentVariablesDataSource returns all the environment variables of a project
+func EnvironmentVariablesDataSource() datasource.DataSource {
+	return &environmentVariablesDataSource{}
+}
+
+type environmentVariablesDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *environmentVariablesDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *environmentVariablesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+}
+
+func (d *environmentVariablesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceAllSchema
+}
+
+// environmentVariablesDataSourceVariables converts the matrix of environment variables returned by the API to the model,
+// sorted by name and with the empty values removed
+func environmentVariablesDataSourceVariables(
+	ctx context.Context,
+	environmentVariables map[string]map[string]dbt_cloud.EnvironmentVariableNameValue,
+) ([]EnvironmentVariablesDataSourceVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := environmentVariablesValues(environmentVariables)
+	variables := []EnvironmentVariablesDataSourceVariable{}
+	for _, name := range sortedKeys(values) {
+		environmentValues, mapDiags := types.MapValueFrom(ctx, types.StringType, values[name])
+		diags.Append(mapDiags...)
+		variables = append(variables, EnvironmentVariablesDataSourceVariable{
+			Name:              types.StringValue(name),
+			IsSecret:          types.BoolValue(helper.IsSecretEnvironmentVariable(name)),
+			EnvironmentValues: environmentValues,
+		})
+	}
+	return variables, diags
+}
+
+// getJobOverrides returns the overrides of the job, or of all the jobs of the environment, sorted by job and name
+func (d *environmentVariablesDataSource) getJobOverrides(
+	projectID int,
+	jobID int,
+	environmentID int,
+) ([]dbt_cloud.EnvironmentVariableJobOverride, error) {
+	jobIDs := []int{}
+	if jobID != 0 {
+		jobIDs = append(jobIDs, jobID)
+	}
+	if environmentID != 0 {
+		jobs, err := d.client.GetAllJobs(0, environmentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range jobs {
+			jobIDs = append(jobIDs, *job.ID)
+		}
+		sort.Ints(jobIDs)
+	}
+
+	overrides := []dbt_cloud.EnvironmentVariableJobOverride{}
+	for _, id := range jobIDs {
+		jobOverrides, err := d.client.GetEnvironmentVariableJobOverrides(projectID, id)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, jobOverrides...)
+	}
+	return overrides, nil
+}
+
+func (d *environmentVariablesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config EnvironmentVariablesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(config.ProjectID.ValueInt64())
+
+	environmentVariables, err := d.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading the environment variables",
+			fmt.Sprintf("Could not read the environment variables of project %d: %s", projectID, err.Error()),
+		)
+		return
+	}
+
+	state := config
+
+	variables, diags := environmentVariablesDataSourceVariables(ctx, environmentVariables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.EnvironmentVariables = variables
+
+	overrides, err := d.getJobOverrides(
+		projectID,
+		int(config.JobID.ValueInt64()),
+		int(config.EnvironmentID.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading the environment variable job overrides",
+			fmt.Sprintf("Could not read the environment variable job overrides of project %d: %s", projectID, err.Error()),
+		)
+		return
+	}
+
+	state.JobOverrides = []EnvironmentVariablesDataSourceJobOverride{}
+	for _, override := range overrides {
+		state.JobOverrides = append(state.JobOverrides, EnvironmentVariablesDataSourceJobOverride{
+			ID:       types.Int64PointerValue(helper.IntPointerToInt64Pointer(override.ID)),
+			JobID:    types.Int64Value(int64(override.JobDefinitionID)),
+			Name:     types.StringValue(override.Name),
+			RawValue: types.StringValue(override.RawValue),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
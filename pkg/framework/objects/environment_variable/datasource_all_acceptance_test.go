@@ -0,0 +1,145 @@
+package environment_variable_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudEnvironmentVariablesDataSource(t *testing.T) {
+
+	projectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	environmentName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	environmentVariableName := strings.ToUpper(
+		acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum),
+	)
+
+	config := environmentVariables(projectName, environmentName, environmentVariableName)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			"environment_variables.#",
+			"1",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			"environment_variables.0.name",
+			fmt.Sprintf("DBT_%s", environmentVariableName),
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			"environment_variables.0.is_secret",
+			"false",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			"environment_variables.0.environment_values.project",
+			"Baa",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			fmt.Sprintf("environment_variables.0.environment_values.%s", environmentName),
+			"Moo",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.all",
+			"job_overrides.#",
+			"0",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.with_job_overrides",
+			"job_overrides.#",
+			"1",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.with_job_overrides",
+			"job_overrides.0.raw_value",
+			"Oink",
+		),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_environment_variables.with_environment_overrides",
+			"job_overrides.#",
+			"1",
+		),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func environmentVariables(projectName, environmentName, environmentVariableName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name        = "DBT_%s"
+  project_id = dbtcloud_project.test_project.id
+  environment_values = {
+    "project": "Baa",
+    "%s": "Moo"
+  }
+  depends_on = [
+    dbtcloud_project.test_project,
+    dbtcloud_environment.test_env
+  ]
+}
+
+resource "dbtcloud_job" "test_job" {
+  environment_id = dbtcloud_environment.test_env.environment_id
+  execute_steps = ["dbt test"]
+  name = "test_job"
+  project_id = dbtcloud_project.test_project.id
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false
+  }
+}
+
+resource "dbtcloud_environment_variable_job_override" "test_override" {
+  job_definition_id = dbtcloud_job.test_job.id
+  project_id = dbtcloud_project.test_project.id
+  name = dbtcloud_environment_variable.test_env_var.name
+  raw_value = "Oink"
+}
+
+data "dbtcloud_environment_variables" "all" {
+  project_id = dbtcloud_project.test_project.id
+  depends_on = [dbtcloud_environment_variable.test_env_var]
+}
+
+data "dbtcloud_environment_variables" "with_job_overrides" {
+  project_id = dbtcloud_project.test_project.id
+  job_id = dbtcloud_job.test_job.id
+  depends_on = [dbtcloud_environment_variable_job_override.test_override]
+}
+
+data "dbtcloud_environment_variables" "with_environment_overrides" {
+  project_id = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_env.environment_id
+  depends_on = [dbtcloud_environment_variable_job_override.test_override]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, environmentVariableName, environmentName)
+}
@@ -0,0 +1,38 @@
+package environment_variable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentVariablesDataSourceVariables(t *testing.T) {
+	variables, diags := environmentVariablesDataSourceVariables(
+		context.Background(),
+		map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{
+			"DBT_VAR": {
+				"project": {ID: 1, Value: "a"},
+				"Prod":    {ID: 0, Value: ""},
+			},
+			"DBT_ENV_SECRET_TOKEN": {
+				"project": {ID: 2, Value: "*****"},
+			},
+		},
+	)
+	assert.False(t, diags.HasError())
+	assert.Len(t, variables, 2)
+
+	assert.Equal(t, "DBT_ENV_SECRET_TOKEN", variables[0].Name.ValueString())
+	assert.True(t, variables[0].IsSecret.ValueBool())
+
+	assert.Equal(t, "DBT_VAR", variables[1].Name.ValueString())
+	assert.False(t, variables[1].IsSecret.ValueBool())
+	assert.Equal(t, types.MapValueMust(
+		types.StringType,
+		map[string]attr.Value{"project": types.StringValue("a")},
+	), variables[1].EnvironmentValues)
+}
@@ -6,10 +6,12 @@ import (
 
 // EnvironmentVariableResourceModel is the model for the resource
 type EnvironmentVariableResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	ProjectID         types.Int64  `tfsdk:"project_id"`
-	Name              types.String `tfsdk:"name"`
-	EnvironmentValues types.Map    `tfsdk:"environment_values"`
+	ID                          types.String `tfsdk:"id"`
+	ProjectID                   types.Int64  `tfsdk:"project_id"`
+	Name                        types.String `tfsdk:"name"`
+	EnvironmentValues           types.Map    `tfsdk:"environment_values"`
+	SecretEnvironmentValues     types.Map    `tfsdk:"secret_environment_values"`
+	SecretEnvironmentValuesHash types.String `tfsdk:"secret_environment_values_hash"`
 }
 
 // EnvironmentVariableDataSourceModel is the model for the data source
@@ -19,3 +21,36 @@ type EnvironmentVariableDataSourceModel struct {
 	Name              types.String `tfsdk:"name"`
 	EnvironmentValues types.Map    `tfsdk:"environment_values"`
 }
+
+// EnvironmentVariablesResourceModel is the model for the resource managing all the environment variables of a project
+type EnvironmentVariablesResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ProjectID              types.Int64  `tfsdk:"project_id"`
+	Variables              types.Map    `tfsdk:"variables"`
+	SecretVariables        types.Map    `tfsdk:"secret_variables"`
+	SecretVariableNames    types.Set    `tfsdk:"secret_variable_names"`
+	SecretVariablesHash    types.String `tfsdk:"secret_variables_hash"`
+	AllowDeletingUnmanaged types.Bool   `tfsdk:"allow_deleting_unmanaged"`
+}
+
+// EnvironmentVariablesDataSourceModel is the model for the data source listing all the environment variables of a project
+type EnvironmentVariablesDataSourceModel struct {
+	ProjectID            types.Int64                                 `tfsdk:"project_id"`
+	JobID                types.Int64                                 `tfsdk:"job_id"`
+	EnvironmentID        types.Int64                                 `tfsdk:"environment_id"`
+	EnvironmentVariables []EnvironmentVariablesDataSourceVariable    `tfsdk:"environment_variables"`
+	JobOverrides         []EnvironmentVariablesDataSourceJobOverride `tfsdk:"job_overrides"`
+}
+
+type EnvironmentVariablesDataSourceVariable struct {
+	Name              types.String `tfsdk:"name"`
+	IsSecret          types.Bool   `tfsdk:"is_secret"`
+	EnvironmentValues types.Map    `tfsdk:"environment_values"`
+}
+
+type EnvironmentVariablesDataSourceJobOverride struct {
+	ID       types.Int64  `tfsdk:"id"`
+	JobID    types.Int64  `tfsdk:"job_id"`
+	Name     types.String `tfsdk:"name"`
+	RawValue types.String `tfsdk:"raw_value"`
+}
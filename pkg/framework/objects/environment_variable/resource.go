@@ -14,9 +14,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &environmentVariableResource{}
-	_ resource.ResourceWithConfigure   = &environmentVariableResource{}
-	_ resource.ResourceWithImportState = &environmentVariableResource{}
+	_ resource.Resource                   = &environmentVariableResource{}
+	_ resource.ResourceWithConfigure      = &environmentVariableResource{}
+	_ resource.ResourceWithImportState    = &environmentVariableResource{}
+	_ resource.ResourceWithValidateConfig = &environmentVariableResource{}
+	_ resource.ResourceWithModifyPlan     = &environmentVariableResource{}
 )
 
 // EnvironmentVariableResource is a helper function to simplify the provider implementation.
@@ -88,13 +90,11 @@ func (r *environmentVariableResource) Create(
 
 	projectID := int(plan.ProjectID.ValueInt64())
 	name := plan.Name.ValueString()
-	environmentValues := plan.EnvironmentValues.Elements()
 
-	envValuesMap := make(map[string]string)
-	for key, value := range environmentValues {
-		if valueStr, ok := value.(types.String); ok {
-			envValuesMap[key] = valueStr.ValueString()
-		}
+	envValuesMap, diags := getEnvironmentValues(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create new envVar
@@ -114,6 +114,11 @@ func (r *environmentVariableResource) Create(
 	// Map response body to schema and populate computed values
 	plan.ID = types.StringValue(fmt.Sprintf("%d:%s", projectID, envVar.Name))
 
+	resp.Diagnostics.Append(setSecretEnvironmentValuesHash(&plan, envValuesMap)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -199,15 +204,20 @@ func (r *environmentVariableResource) Update(
 		return
 	}
 
+	environmentValues, diags := getEnvironmentValues(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update values for previously existing environments and disregard those missing from plan
-	environmentValues := plan.EnvironmentValues.Elements()
 	envValuesMap := make(map[string]string)
 	for key, keyValuePair := range currentEnvVar.EnvironmentNameValues {
 		idStr := strconv.Itoa(keyValuePair.ID)
 		// We assume the value will be deleted
 		envValuesMap[idStr] = ""
-		if valueStr, ok := environmentValues[key].(types.String); ok {
-			envValuesMap[idStr] = valueStr.ValueString()
+		if value, ok := environmentValues[key]; ok {
+			envValuesMap[idStr] = value
 		}
 	}
 
@@ -216,9 +226,7 @@ func (r *environmentVariableResource) Update(
 		for key, value := range environmentValues {
 			_, exists := currentEnvVar.EnvironmentNameValues[key]
 			if !exists {
-				if valueStr, ok := value.(types.String); ok {
-					envValuesMap[key] = valueStr.ValueString()
-				}
+				envValuesMap[key] = value
 			}
 		}
 	}
@@ -243,6 +251,11 @@ func (r *environmentVariableResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(setSecretEnvironmentValuesHash(&plan, environmentValues)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
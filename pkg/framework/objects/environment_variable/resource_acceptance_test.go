@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func getTestInputData() (string, string, string) {
@@ -54,6 +55,96 @@ func TestAccDbtCloudEnvironmentVariableResourceSecret(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudEnvironmentVariableResourceWriteOnlySecret(t *testing.T) {
+
+	projectName, environmentName, environmentVariableName := getTestInputData()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		// write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableDestroy,
+		Steps: []resource.TestStep{
+			getWriteOnlySecretEnvTestStep(projectName, environmentName, environmentVariableName, "Baa"),
+			// the config doesn't change, so the hash and the plan stay the same
+			{
+				Config: testAccDbtCloudEnvironmentVariableResourceWriteOnlySecretConfig(
+					projectName,
+					environmentName,
+					environmentVariableName,
+					"Baa",
+				),
+				PlanOnly: true,
+			},
+			// changing the secret value updates the variable
+			getWriteOnlySecretEnvTestStep(projectName, environmentName, environmentVariableName, "Oink"),
+		},
+	})
+}
+
+func getWriteOnlySecretEnvTestStep(
+	projectName, environmentName, environmentVariableName, secretValue string,
+) resource.TestStep {
+	return resource.TestStep{
+		Config: testAccDbtCloudEnvironmentVariableResourceWriteOnlySecretConfig(
+			projectName,
+			environmentName,
+			environmentVariableName,
+			secretValue,
+		),
+		Check: resource.ComposeTestCheckFunc(
+			testAccCheckDbtCloudEnvironmentVariableExists(
+				"dbtcloud_environment_variable.test_env_var",
+			),
+			resource.TestCheckNoResourceAttr(
+				"dbtcloud_environment_variable.test_env_var",
+				"environment_values.%",
+			),
+			resource.TestCheckNoResourceAttr(
+				"dbtcloud_environment_variable.test_env_var",
+				"secret_environment_values.%",
+			),
+			resource.TestCheckResourceAttrSet(
+				"dbtcloud_environment_variable.test_env_var",
+				"secret_environment_values_hash",
+			),
+		),
+	}
+}
+
+func testAccDbtCloudEnvironmentVariableResourceWriteOnlySecretConfig(
+	projectName, environmentName, environmentVariableName, secretValue string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name        = "DBT_ENV_SECRET_%s"
+  project_id = dbtcloud_project.test_project.id
+  secret_environment_values = {
+    "project": "%s",
+    "%s": "Moo"
+  }
+  depends_on = [
+    dbtcloud_project.test_project,
+    dbtcloud_environment.test_env
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, environmentVariableName, secretValue, environmentName)
+}
+
 func TestAccDbtCloudEnvironmentVariableResourceModify(t *testing.T) {
 
 	projectName, environmentName, environmentVariableName := getTestInputData()
@@ -0,0 +1,667 @@
+package environment_variable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &environmentVariablesResource{}
+	_ resource.ResourceWithConfigure      = &environmentVariablesResource{}
+	_ resource.ResourceWithImportState    = &environmentVariablesResource{}
+	_ resource.ResourceWithModifyPlan     = &environmentVariablesResource{}
+	_ resource.ResourceWithValidateConfig = &environmentVariablesResource{}
+)
+
+// EnvironmentVariablesResource manages all the environment variables of a project
+func EnvironmentVariablesResource() resource.Resource {
+	return &environmentVariablesResource{}
+}
+
+type environmentVariablesResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *environmentVariablesResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *environmentVariablesResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+}
+
+func (r *environmentVariablesResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = bulkResourceSchema
+}
+
+func (r *environmentVariablesResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Variables.IsNull() || config.Variables.IsUnknown() {
+		return
+	}
+
+	secretNames := []string{}
+	for name := range config.Variables.Elements() {
+		if helper.IsSecretEnvironmentVariable(name) {
+			secretNames = append(secretNames, name)
+		}
+	}
+	if len(secretNames) == 0 {
+		return
+	}
+	sort.Strings(secretNames)
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("variables"),
+		"Secret environment variables in variables",
+		fmt.Sprintf(
+			"%s are secret environment variables and their values are masked by dbt Cloud. "+
+				"Set them in the write-only `secret_variables` instead, so that only a hash of the values is stored in the state.",
+			strings.Join(secretNames, ", "),
+		),
+	)
+}
+
+// ModifyPlan plans the names of the secret variables from the config and detects the changes of `secret_variables`
+// by comparing the values in the config with the hash in the state,
+// as the write-only values are never in the plan or the state and the secret values returned by the API are masked
+func (r *environmentVariablesResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(r.checkUnmanagedEnvironmentVariables(ctx, req.Config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var secretVariables types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_variables"), &secretVariables)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if secretVariables.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variable_names"), types.SetNull(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variables_hash"), types.StringNull())...)
+		return
+	}
+
+	if secretVariables.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variable_names"), types.SetUnknown(types.StringType))...)
+	} else {
+		names := []string{}
+		for name := range secretVariables.Elements() {
+			names = append(names, name)
+		}
+		secretVariableNames, diags := types.SetValueFrom(ctx, types.StringType, names)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variable_names"), secretVariableNames)...)
+	}
+
+	if !req.State.Raw.IsNull() && isFullyKnown(secretVariables) {
+		var stateHash types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret_variables_hash"), &stateHash)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		values := map[string]map[string]string{}
+		resp.Diagnostics.Append(secretVariables.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !stateHash.IsNull() && environmentValuesMatchHash(values, stateHash.ValueString()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variables_hash"), stateHash)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_variables_hash"), types.StringUnknown())...)
+}
+
+// checkUnmanagedEnvironmentVariables reads the environment variables of the project when the resource is created
+// to report the ones that are not in the config and would be deleted, which the plan doesn't show otherwise.
+// The check is skipped when the project or the variable names are not known yet, Create enforcing it then.
+func (r *environmentVariablesResource) checkUnmanagedEnvironmentVariables(
+	ctx context.Context,
+	config tfsdk.Config,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var model EnvironmentVariablesResourceModel
+	diags.Append(config.Get(ctx, &model)...)
+	if diags.HasError() || r.client == nil ||
+		model.ProjectID.IsUnknown() || model.Variables.IsUnknown() || model.SecretVariables.IsUnknown() {
+		return diags
+	}
+
+	projectID := int(model.ProjectID.ValueInt64())
+	current, err := r.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		// the project might not exist yet, the variables are read again when the resource is created
+		return diags
+	}
+
+	managed := map[string]map[string]string{}
+	for name := range model.Variables.Elements() {
+		managed[name] = nil
+	}
+	for name := range model.SecretVariables.Elements() {
+		managed[name] = nil
+	}
+	_, _, unmanaged := diffEnvironmentVariables(current, managed)
+
+	if len(unmanaged) > 0 {
+		diags.Append(unmanagedEnvironmentVariablesDiagnostic(projectID, unmanaged, model.AllowDeletingUnmanaged.ValueBool()))
+	}
+	return diags
+}
+
+// unmanagedEnvironmentVariablesDiagnostic describes the environment variables of the project that are deleted
+// when the resource is created, as an error unless deleting them is allowed
+func unmanagedEnvironmentVariablesDiagnostic(projectID int, names []string, allowDeleting bool) diag.Diagnostic {
+	if allowDeleting {
+		return diag.NewAttributeWarningDiagnostic(
+			path.Root("variables"),
+			"Unmanaged environment variables will be deleted",
+			fmt.Sprintf(
+				"The following environment variables of project %d are not in `variables` or `secret_variables` and will be deleted: %s",
+				projectID,
+				strings.Join(names, ", "),
+			),
+		)
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("variables"),
+		"Unmanaged environment variables would be deleted",
+		fmt.Sprintf(
+			"The following environment variables of project %d are not in `variables` or `secret_variables` and would be deleted: %s. "+
+				"Add them to the config, import the resource first with the project ID (%d) to manage the existing variables, "+
+				"or set `allow_deleting_unmanaged = true` to delete them.",
+			projectID,
+			strings.Join(names, ", "),
+			projectID,
+		),
+	)
+}
+
+// getSecretVariables returns the values of the write-only `secret_variables`, which are only available in the config
+func getSecretVariables(ctx context.Context, config tfsdk.Config) (map[string]map[string]string, diag.Diagnostics) {
+	secretVariables := map[string]map[string]string{}
+
+	var secretVariablesMap types.Map
+	diags := config.GetAttribute(ctx, path.Root("secret_variables"), &secretVariablesMap)
+	if diags.HasError() || secretVariablesMap.IsNull() {
+		return secretVariables, diags
+	}
+	diags.Append(secretVariablesMap.ElementsAs(ctx, &secretVariables, false)...)
+	return secretVariables, diags
+}
+
+// setSecretVariablesState stores the names and the hash of the secret variables in the model, instead of their values
+func setSecretVariablesState(
+	ctx context.Context,
+	model *EnvironmentVariablesResourceModel,
+	secretVariables map[string]map[string]string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.SecretVariables = types.MapNull(types.MapType{ElemType: types.StringType})
+	if len(secretVariables) == 0 {
+		model.SecretVariableNames = types.SetNull(types.StringType)
+		model.SecretVariablesHash = types.StringNull()
+		return diags
+	}
+
+	model.SecretVariableNames, diags = types.SetValueFrom(ctx, types.StringType, sortedKeys(secretVariables))
+	hash, err := hashEnvironmentValues(secretVariables)
+	if err != nil {
+		diags.AddError("Error hashing the secret environment variables", err.Error())
+		return diags
+	}
+	model.SecretVariablesHash = types.StringValue(hash)
+	return diags
+}
+
+// environmentVariablesValues removes the empty values from the matrix returned by the API,
+// an empty value meaning that the variable is not set for the environment
+func environmentVariablesValues(
+	environmentVariables map[string]map[string]dbt_cloud.EnvironmentVariableNameValue,
+) map[string]map[string]string {
+	values := map[string]map[string]string{}
+	for name, environmentValues := range environmentVariables {
+		values[name] = map[string]string{}
+		for environmentName, environmentValue := range environmentValues {
+			if environmentValue.Value != "" {
+				values[name][environmentName] = environmentValue.Value
+			}
+		}
+	}
+	return values
+}
+
+// addSecretVariables adds the secret variables to the planned variables.
+// Their values can't be compared with the ones of the API, which are masked, so when they didn't change in the config
+// the variables that already exist are planned with their current values to not be updated.
+func addSecretVariables(
+	planned map[string]map[string]string,
+	secretVariables map[string]map[string]string,
+	current map[string]map[string]dbt_cloud.EnvironmentVariableNameValue,
+	secretsChanged bool,
+) {
+	for name, values := range secretVariables {
+		if currentValues, ok := current[name]; ok && !secretsChanged {
+			planned[name] = environmentVariablesValues(
+				map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{name: currentValues},
+			)[name]
+			continue
+		}
+		planned[name] = values
+	}
+}
+
+// diffEnvironmentVariables compares the current environment variables of the project with the planned ones.
+// It returns the values of the variables to create, the payloads of the variables to update and the names of the variables to delete.
+// For the updates, existing values are referenced by their ID, with an empty string to remove the value, and new values by their environment name.
+func diffEnvironmentVariables(
+	current map[string]map[string]dbt_cloud.EnvironmentVariableNameValue,
+	planned map[string]map[string]string,
+) (
+	toCreate map[string]map[string]string,
+	toUpdate map[string]map[string]string,
+	toDelete []string,
+) {
+	toCreate = map[string]map[string]string{}
+	toUpdate = map[string]map[string]string{}
+	toDelete = []string{}
+
+	for name := range current {
+		if _, ok := planned[name]; !ok {
+			toDelete = append(toDelete, name)
+		}
+	}
+	sort.Strings(toDelete)
+
+	for name, plannedValues := range planned {
+		currentValues, ok := current[name]
+		if !ok {
+			toCreate[name] = plannedValues
+			continue
+		}
+
+		changed := false
+		updateValues := map[string]string{}
+		for environmentName, currentValue := range currentValues {
+			if currentValue.ID == 0 {
+				continue
+			}
+			plannedValue := plannedValues[environmentName]
+			updateValues[strconv.Itoa(currentValue.ID)] = plannedValue
+			if plannedValue != currentValue.Value {
+				changed = true
+			}
+		}
+		for environmentName, plannedValue := range plannedValues {
+			if currentValue, exists := currentValues[environmentName]; !exists || currentValue.ID == 0 {
+				updateValues[environmentName] = plannedValue
+				changed = true
+			}
+		}
+
+		if changed {
+			toUpdate[name] = updateValues
+		}
+	}
+
+	return toCreate, toUpdate, toDelete
+}
+
+func sortedKeys(values map[string]map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyEnvironmentVariables creates, updates and deletes the environment variables of the project to match the plan
+// and the secret variables of the config.
+// Only the variables with changes are sent to the API, the API taking one variable per call.
+// When deleteUnmanaged is false, nothing is written if variables would be deleted.
+func (r *environmentVariablesResource) applyEnvironmentVariables(
+	ctx context.Context,
+	plan EnvironmentVariablesResourceModel,
+	secretVariables map[string]map[string]string,
+	secretsChanged bool,
+	deleteUnmanaged bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projectID := int(plan.ProjectID.ValueInt64())
+
+	planned := map[string]map[string]string{}
+	diags.Append(plan.Variables.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		diags.AddError(
+			"Error reading the environment variables",
+			"Could not read the environment variables of project "+strconv.Itoa(projectID)+": "+err.Error(),
+		)
+		return diags
+	}
+	addSecretVariables(planned, secretVariables, current, secretsChanged)
+
+	toCreate, toUpdate, toDelete := diffEnvironmentVariables(current, planned)
+	if len(toDelete) > 0 && !deleteUnmanaged {
+		diags.Append(unmanagedEnvironmentVariablesDiagnostic(projectID, toDelete, false))
+		return diags
+	}
+
+	for _, name := range toDelete {
+		_, err := r.client.DeleteEnvironmentVariable(name, projectID)
+		if err != nil {
+			diags.AddError(
+				"Error deleting environment variable",
+				"Could not delete environment variable "+name+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for _, name := range sortedKeys(toUpdate) {
+		_, err := r.client.UpdateEnvironmentVariable(
+			projectID,
+			dbt_cloud.AbstractedEnvironmentVariable{
+				Name:              name,
+				ProjectID:         projectID,
+				EnvironmentValues: toUpdate[name],
+			},
+		)
+		if err != nil {
+			diags.AddError(
+				"Error updating environment variable",
+				"Could not update environment variable "+name+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for _, name := range sortedKeys(toCreate) {
+		environmentValues := map[string]string{}
+		for environmentName, value := range toCreate[name] {
+			environmentValues[environmentName] = value
+		}
+
+		_, err := r.client.CreateEnvironmentVariable(projectID, name, environmentValues)
+		if err != nil {
+			diags.AddError(
+				"Error creating environment variable",
+				"Could not create environment variable "+name+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *environmentVariablesResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretVariables, diags := getSecretVariables(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyEnvironmentVariables(
+		ctx,
+		plan,
+		secretVariables,
+		true,
+		plan.AllowDeletingUnmanaged.ValueBool(),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.ProjectID.ValueInt64(), 10))
+	resp.Diagnostics.Append(setSecretVariablesState(ctx, &plan, secretVariables)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *environmentVariablesResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+
+	current, err := r.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		if strings.Contains(err.Error(), "resource-not-found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading the environment variables",
+			"Could not read the environment variables of project "+strconv.Itoa(projectID)+": "+err.Error(),
+		)
+		return
+	}
+
+	// the values of the secret variables are masked, so only their names are read to detect the ones added or deleted
+	values := environmentVariablesValues(current)
+	secretVariableNames := []string{}
+	for name := range values {
+		if helper.IsSecretEnvironmentVariable(name) {
+			secretVariableNames = append(secretVariableNames, name)
+			delete(values, name)
+		}
+	}
+
+	variables, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(projectID))
+	state.Variables = variables
+	state.SecretVariables = types.MapNull(types.MapType{ElemType: types.StringType})
+	state.SecretVariableNames = types.SetNull(types.StringType)
+	if len(secretVariableNames) > 0 {
+		sort.Strings(secretVariableNames)
+		state.SecretVariableNames, diags = types.SetValueFrom(ctx, types.StringType, secretVariableNames)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *environmentVariablesResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretVariables, diags := getSecretVariables(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secretsChanged := state.SecretVariablesHash.IsNull() ||
+		!environmentValuesMatchHash(secretVariables, state.SecretVariablesHash.ValueString())
+
+	resp.Diagnostics.Append(r.applyEnvironmentVariables(ctx, plan, secretVariables, secretsChanged, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setSecretVariablesState(ctx, &plan, secretVariables)...)
+	// the hash is salted, so the one of the state is kept when the secret values didn't change
+	if !secretsChanged {
+		plan.SecretVariablesHash = state.SecretVariablesHash
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *environmentVariablesResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+
+	current, err := r.client.GetEnvironmentVariables(projectID)
+	if err != nil {
+		if strings.Contains(err.Error(), "resource-not-found") {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading the environment variables",
+			"Could not read the environment variables of project "+strconv.Itoa(projectID)+": "+err.Error(),
+		)
+		return
+	}
+
+	managedNames := []string{}
+	for name := range state.Variables.Elements() {
+		managedNames = append(managedNames, name)
+	}
+	if !state.SecretVariableNames.IsNull() {
+		secretVariableNames := []string{}
+		resp.Diagnostics.Append(state.SecretVariableNames.ElementsAs(ctx, &secretVariableNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		managedNames = append(managedNames, secretVariableNames...)
+	}
+
+	names := []string{}
+	for _, name := range managedNames {
+		if _, ok := current[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, err := r.client.DeleteEnvironmentVariable(name, projectID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting environment variable",
+				"Could not delete environment variable "+name+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *environmentVariablesResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format: project_id. Got: %q",
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
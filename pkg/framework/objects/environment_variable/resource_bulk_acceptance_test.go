@@ -0,0 +1,142 @@
+package environment_variable_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudEnvironmentVariablesResource(t *testing.T) {
+
+	projectName, environmentName, environmentVariableName := getTestInputData()
+	otherEnvironmentVariableName := strings.ToUpper(
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentVariablesResourceConfig(
+					projectName,
+					environmentName,
+					fmt.Sprintf(`
+    "DBT_%s" = {
+      "project" : "Baa",
+      "%s" : "Moo"
+    }
+    "DBT_%s" = {
+      "project" : "Oink"
+    }`, environmentVariableName, environmentName, otherEnvironmentVariableName),
+					fmt.Sprintf(`
+    "DBT_ENV_SECRET_%s" = {
+      "project" : "Shhh"
+    }`, environmentVariableName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						"secret_variable_names.#",
+						"1",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_environment_variables.test_env_vars",
+						"secret_variables_hash",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						"variables.%",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						fmt.Sprintf("variables.DBT_%s.project", environmentVariableName),
+						"Baa",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						fmt.Sprintf("variables.DBT_%s.%s", environmentVariableName, environmentName),
+						"Moo",
+					),
+				),
+			},
+			// modify a value, remove an environment value and remove a variable
+			{
+				Config: testAccDbtCloudEnvironmentVariablesResourceConfig(
+					projectName,
+					environmentName,
+					fmt.Sprintf(`
+    "DBT_%s" = {
+      "project" : "Neigh"
+    }`, environmentVariableName),
+					"",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						"secret_variable_names.#",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						"variables.%",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						fmt.Sprintf("variables.DBT_%s.%%", environmentVariableName),
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test_env_vars",
+						fmt.Sprintf("variables.DBT_%s.project", environmentVariableName),
+						"Neigh",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_environment_variables.test_env_vars",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariablesResourceConfig(
+	projectName, environmentName, variables, secretVariables string,
+) string {
+	if secretVariables != "" {
+		secretVariables = fmt.Sprintf(`
+  secret_variables = {%s
+  }`, secretVariables)
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variables" "test_env_vars" {
+  project_id = dbtcloud_project.test_project.id
+  variables = {%s
+  }%s
+  depends_on = [
+    dbtcloud_environment.test_env
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, variables, secretVariables)
+}
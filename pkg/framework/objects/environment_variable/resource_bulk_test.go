@@ -0,0 +1,106 @@
+package environment_variable
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffEnvironmentVariables(t *testing.T) {
+	current := map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{
+		"DBT_UNCHANGED": {
+			"project": {ID: 1, Value: "a"},
+			"Prod":    {ID: 2, Value: "b"},
+		},
+		"DBT_UPDATED": {
+			"project": {ID: 3, Value: "c"},
+			"Prod":    {ID: 4, Value: "d"},
+		},
+		"DBT_DELETED": {
+			"project": {ID: 5, Value: "e"},
+		},
+	}
+	planned := map[string]map[string]string{
+		"DBT_UNCHANGED": {"project": "a", "Prod": "b"},
+		"DBT_UPDATED":   {"project": "c2", "Dev": "f"},
+		"DBT_NEW":       {"project": "g"},
+	}
+
+	toCreate, toUpdate, toDelete := diffEnvironmentVariables(current, planned)
+
+	assert.Equal(t, map[string]map[string]string{
+		"DBT_NEW": {"project": "g"},
+	}, toCreate)
+	assert.Equal(t, map[string]map[string]string{
+		// existing values are referenced by ID, removed values are set to an empty string
+		"DBT_UPDATED": {"3": "c2", "4": "", "Dev": "f"},
+	}, toUpdate)
+	assert.Equal(t, []string{"DBT_DELETED"}, toDelete)
+
+	// an empty map deletes all the variables
+	toCreate, toUpdate, toDelete = diffEnvironmentVariables(current, map[string]map[string]string{})
+	assert.Empty(t, toCreate)
+	assert.Empty(t, toUpdate)
+	assert.Equal(t, []string{"DBT_DELETED", "DBT_UNCHANGED", "DBT_UPDATED"}, toDelete)
+}
+
+func TestEnvironmentVariablesValues(t *testing.T) {
+	values := environmentVariablesValues(map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{
+		"DBT_VAR": {
+			"project": {ID: 1, Value: "a"},
+			"Prod":    {ID: 0, Value: ""},
+		},
+	})
+
+	assert.Equal(t, map[string]map[string]string{"DBT_VAR": {"project": "a"}}, values)
+}
+
+func TestAddSecretVariables(t *testing.T) {
+	current := map[string]map[string]dbt_cloud.EnvironmentVariableNameValue{
+		"DBT_ENV_SECRET_TOKEN": {
+			"project": {ID: 1, Value: "*****"},
+			"Prod":    {ID: 2, Value: "*****"},
+		},
+	}
+	secretVariables := map[string]map[string]string{
+		"DBT_ENV_SECRET_TOKEN": {"project": "secret", "Prod": "prod-secret"},
+		"DBT_ENV_SECRET_NEW":   {"project": "new-secret"},
+	}
+
+	// unchanged secrets keep the masked values of the existing variables, only the new ones are planned with their values
+	planned := map[string]map[string]string{"DBT_VAR": {"project": "a"}}
+	addSecretVariables(planned, secretVariables, current, false)
+	assert.Equal(t, map[string]map[string]string{
+		"DBT_VAR":              {"project": "a"},
+		"DBT_ENV_SECRET_TOKEN": {"project": "*****", "Prod": "*****"},
+		"DBT_ENV_SECRET_NEW":   {"project": "new-secret"},
+	}, planned)
+
+	toCreate, toUpdate, toDelete := diffEnvironmentVariables(current, planned)
+	assert.Equal(t, []string{"DBT_ENV_SECRET_NEW", "DBT_VAR"}, sortedKeys(toCreate))
+	assert.Empty(t, toUpdate)
+	assert.Empty(t, toDelete)
+
+	// changed secrets are all planned with the values of the config
+	planned = map[string]map[string]string{}
+	addSecretVariables(planned, secretVariables, current, true)
+	assert.Equal(t, secretVariables, planned)
+}
+
+func TestUnmanagedEnvironmentVariablesDiagnostic(t *testing.T) {
+	names := []string{"DBT_A", "DBT_B"}
+
+	// deleting the unmanaged variables needs an opt-in
+	diagnostic := unmanagedEnvironmentVariablesDiagnostic(10, names, false)
+	assert.Equal(t, diag.SeverityError, diagnostic.Severity())
+	assert.Contains(t, diagnostic.Detail(), "project 10")
+	assert.Contains(t, diagnostic.Detail(), "DBT_A, DBT_B")
+	assert.Contains(t, diagnostic.Detail(), "allow_deleting_unmanaged")
+
+	// and is still reported once allowed
+	diagnostic = unmanagedEnvironmentVariablesDiagnostic(10, names, true)
+	assert.Equal(t, diag.SeverityWarning, diagnostic.Severity())
+	assert.Contains(t, diagnostic.Detail(), "DBT_A, DBT_B")
+}
@@ -1,11 +1,19 @@
 package environment_variable
 
 import (
+	"regexp"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,9 +39,107 @@ var resourceSchema = resource_schema.Schema{
 			Description: "Name for the variable, must be unique within a project, must be prefixed with 'DBT_'",
 		},
 		"environment_values": resource_schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Map from environment names to respective variable value, a special key `project` should be set for the project default variable value. This field is not set as sensitive so take precautions when using secret environment variables, and use `secret_environment_values` instead.",
+			Validators: []validator.Map{
+				mapvalidator.ExactlyOneOf(path.MatchRoot("secret_environment_values")),
+			},
+		},
+		"secret_environment_values": resource_schema.MapAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: helper.DocString(
+				`Write-only map from environment names to respective secret variable value, a special key ~~~project~~~ should be set for the project default variable value.
+				Can only be used for variables prefixed with ~~~DBT_ENV_SECRET_~~~. The values are never stored in the state, only a salted hash is kept to detect changes. Requires Terraform 1.11 or later.`,
+			),
+		},
+		"secret_environment_values_hash": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "Salted hash of `secret_environment_values`, used to detect changes of the secret values in the config",
+		},
+	},
+}
+
+var bulkResourceSchema = resource_schema.Schema{
+	Description: helper.DocString(
+		`Manage all the environment variables of a project in a single resource.
+
+		This resource is authoritative: the environment variables of the project that are not in ~~~variables~~~ or ~~~secret_variables~~~ are deleted.
+		When the resource is created, the plan fails if the project already has other environment variables,
+		unless the resource is imported first or ~~~allow_deleting_unmanaged~~~ is set to ~~~true~~~.
+		It should not be used together with ~~~dbtcloud_environment_variable~~~ or ~~~dbtcloud_partial_environment_variable~~~ for the same project.
+
+		The project variables are read with a single API call, and only the variables with changes are written.
+		The dbt Cloud API creates, updates and deletes one variable per call though, so each variable added, changed or removed is a separate API call.`,
+	),
+	Attributes: map[string]resource_schema.Attribute{
+		"id": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource. Contains the project ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": resource_schema.Int64Attribute{
 			Required:    true,
+			Description: "Project ID to manage the environment variables of",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"variables": resource_schema.MapAttribute{
+			Required: true,
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+			Description: "Map from the environment variable names, which must be prefixed with 'DBT_', to a map from environment names to the respective variable value. A special key `project` should be set for the project default variable value. Secret environment variables, prefixed with `DBT_ENV_SECRET_`, can't be set here and should use `secret_variables`.",
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^DBT_`),
+						"The environment variable name must start with DBT_",
+					),
+				),
+			},
+		},
+		"secret_variables": resource_schema.MapAttribute{
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+			Description: helper.DocString(
+				`Write-only map from the secret environment variable names, which must be prefixed with ~~~DBT_ENV_SECRET_~~~, to a map from environment names to the respective variable value.
+				The values are never stored in the state, only a salted hash is kept to detect changes. Requires Terraform 1.11 or later.`,
+			),
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^`+helper.SecretEnvironmentVariablePrefix),
+						"The secret environment variable name must start with "+helper.SecretEnvironmentVariablePrefix,
+					),
+				),
+			},
+		},
+		"allow_deleting_unmanaged": resource_schema.BoolAttribute{
+			Optional: true,
+			Description: helper.DocString(
+				`Whether the environment variables already in the project that are not in ~~~variables~~~ or ~~~secret_variables~~~ can be deleted when the resource is created.
+				When not set, the plan fails and lists those variables, which can be added to the config or imported first. Once created, the resource manages all the variables of the project.`,
+			),
+		},
+		"secret_variable_names": resource_schema.SetAttribute{
+			Computed:    true,
 			ElementType: types.StringType,
-			Description: "Map from environment names to respective variable value, a special key `project` should be set for the project default variable value. This field is not set as sensitive so take precautions when using secret environment variables.",
+			Description: "Names of the secret environment variables managed with `secret_variables`",
+		},
+		"secret_variables_hash": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "Salted hash of `secret_variables`, used to detect changes of the secret values in the config",
 		},
 	},
 }
@@ -60,3 +166,73 @@ var datasourceSchema = datasource_schema.Schema{
 		},
 	},
 }
+
+var datasourceAllSchema = datasource_schema.Schema{
+	Description: helper.DocString(
+		`Retrieve all the environment variables of a project, with their values for each environment, and optionally the job overrides of a job or of all the jobs of an environment.
+
+		The values of secret environment variables, prefixed with ~~~DBT_ENV_SECRET_~~~, are masked by dbt Cloud.`,
+	),
+	Attributes: map[string]datasource_schema.Attribute{
+		"project_id": datasource_schema.Int64Attribute{
+			Required:    true,
+			Description: "Project ID to retrieve the environment variables from",
+		},
+		"job_id": datasource_schema.Int64Attribute{
+			Optional:    true,
+			Description: "Job ID to retrieve the environment variable overrides of - can't be set together with `environment_id`",
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("environment_id")),
+			},
+		},
+		"environment_id": datasource_schema.Int64Attribute{
+			Optional:    true,
+			Description: "Environment ID to retrieve the environment variable overrides of all its jobs - can't be set together with `job_id`",
+		},
+		"environment_variables": datasource_schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The environment variables of the project, sorted by name",
+			NestedObject: datasource_schema.NestedAttributeObject{
+				Attributes: map[string]datasource_schema.Attribute{
+					"name": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the environment variable",
+					},
+					"is_secret": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the environment variable is a secret one, prefixed with `DBT_ENV_SECRET_`, with values masked",
+					},
+					"environment_values": datasource_schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Map from environment names to respective variable value, the special key `project` contains the project default value",
+					},
+				},
+			},
+		},
+		"job_overrides": datasource_schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The environment variable overrides of the job set in `job_id` or of all the jobs of the environment set in `environment_id`. Empty when none of them is set.",
+			NestedObject: datasource_schema.NestedAttributeObject{
+				Attributes: map[string]datasource_schema.Attribute{
+					"id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the environment variable job override",
+					},
+					"job_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the job the override is for",
+					},
+					"name": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the environment variable overridden",
+					},
+					"raw_value": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Value of the override, masked for secret environment variables",
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,177 @@
+package environment_variable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getEnvironmentValues returns the values of the environment variable from `environment_values`
+// or, for secret environment variables, from the write-only `secret_environment_values` which is only available in the config
+func getEnvironmentValues(
+	ctx context.Context,
+	plan EnvironmentVariableResourceModel,
+	config tfsdk.Config,
+) (map[string]string, diag.Diagnostics) {
+	environmentValues := map[string]string{}
+
+	if !plan.EnvironmentValues.IsNull() {
+		diags := plan.EnvironmentValues.ElementsAs(ctx, &environmentValues, false)
+		return environmentValues, diags
+	}
+
+	var secretEnvironmentValues types.Map
+	diags := config.GetAttribute(ctx, path.Root("secret_environment_values"), &secretEnvironmentValues)
+	if diags.HasError() {
+		return nil, diags
+	}
+	diags.Append(secretEnvironmentValues.ElementsAs(ctx, &environmentValues, false)...)
+	return environmentValues, diags
+}
+
+// hashEnvironmentValues returns a salted hash of all the values of the environment variable,
+// or of several environment variables when given their values by variable name
+func hashEnvironmentValues(environmentValues any) (string, error) {
+	environmentValuesJSON, err := json.Marshal(environmentValues)
+	if err != nil {
+		return "", err
+	}
+	return helper.HashSecret(string(environmentValuesJSON))
+}
+
+// environmentValuesMatchHash returns true when the values are the ones the hash was generated from
+func environmentValuesMatchHash(environmentValues any, hash string) bool {
+	environmentValuesJSON, err := json.Marshal(environmentValues)
+	if err != nil {
+		return false
+	}
+	return helper.SecretMatchesHash(string(environmentValuesJSON), hash)
+}
+
+// isFullyKnown returns true when the map and all its values, including the values of nested maps, are known
+func isFullyKnown(values types.Map) bool {
+	if values.IsUnknown() {
+		return false
+	}
+	for _, value := range values.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+		if nested, ok := value.(types.Map); ok && !isFullyKnown(nested) {
+			return false
+		}
+	}
+	return true
+}
+
+// setSecretEnvironmentValuesHash stores the hash of the secret values in the model, instead of the values themselves
+func setSecretEnvironmentValuesHash(
+	model *EnvironmentVariableResourceModel,
+	environmentValues map[string]string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.SecretEnvironmentValues = types.MapNull(types.StringType)
+	if !model.EnvironmentValues.IsNull() {
+		model.SecretEnvironmentValuesHash = types.StringNull()
+		return diags
+	}
+
+	hash, err := hashEnvironmentValues(environmentValues)
+	if err != nil {
+		diags.AddError("Error hashing the secret environment values", err.Error())
+		return diags
+	}
+	model.SecretEnvironmentValuesHash = types.StringValue(hash)
+	return diags
+}
+
+func (r *environmentVariableResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config EnvironmentVariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Name.IsUnknown() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	isSecret := helper.IsSecretEnvironmentVariable(name)
+
+	if !config.SecretEnvironmentValues.IsNull() && !isSecret {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_environment_values"),
+			"Invalid secret environment variable",
+			fmt.Sprintf(
+				"`secret_environment_values` can only be used for variables prefixed with %s, use `environment_values` for %s.",
+				helper.SecretEnvironmentVariablePrefix,
+				name,
+			),
+		)
+	}
+
+	if !config.EnvironmentValues.IsNull() && isSecret {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("environment_values"),
+			"Secret values stored in the state",
+			fmt.Sprintf(
+				"%s is a secret environment variable but its values are set in `environment_values` and are stored in plain text in the state. "+
+					"Use `secret_environment_values` instead to only store a hash of the values.",
+				name,
+			),
+		)
+	}
+}
+
+// ModifyPlan detects the changes of `secret_environment_values` by comparing the values in the config with the hash in the state,
+// as the write-only values are never in the plan or the state and the secret values returned by the API are masked
+func (r *environmentVariableResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var secretEnvironmentValues types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_environment_values"), &secretEnvironmentValues)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if secretEnvironmentValues.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_environment_values_hash"), types.StringNull())...)
+		return
+	}
+
+	if !req.State.Raw.IsNull() && isFullyKnown(secretEnvironmentValues) {
+		var stateHash types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret_environment_values_hash"), &stateHash)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		environmentValues := map[string]string{}
+		resp.Diagnostics.Append(secretEnvironmentValues.ElementsAs(ctx, &environmentValues, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !stateHash.IsNull() && environmentValuesMatchHash(environmentValues, stateHash.ValueString()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_environment_values_hash"), stateHash)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_environment_values_hash"), types.StringUnknown())...)
+}
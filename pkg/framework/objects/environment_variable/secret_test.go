@@ -0,0 +1,64 @@
+package environment_variable
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentValuesMatchHash(t *testing.T) {
+	environmentValues := map[string]string{"project": "secret", "Prod": "prod-secret"}
+
+	hash, err := hashEnvironmentValues(environmentValues)
+	assert.NoError(t, err)
+	assert.NotContains(t, hash, "secret")
+
+	assert.True(t, environmentValuesMatchHash(map[string]string{"Prod": "prod-secret", "project": "secret"}, hash))
+	assert.False(t, environmentValuesMatchHash(map[string]string{"project": "secret"}, hash))
+	assert.False(t, environmentValuesMatchHash(map[string]string{"project": "secret", "Prod": "other"}, hash))
+}
+
+func TestSetSecretEnvironmentValuesHash(t *testing.T) {
+	environmentValues := map[string]string{"project": "secret"}
+
+	// secret values are only stored as a hash
+	model := EnvironmentVariableResourceModel{
+		EnvironmentValues:       types.MapNull(types.StringType),
+		SecretEnvironmentValues: types.MapNull(types.StringType),
+	}
+	assert.False(t, setSecretEnvironmentValuesHash(&model, environmentValues).HasError())
+	assert.True(t, model.SecretEnvironmentValues.IsNull())
+	assert.True(t, environmentValuesMatchHash(environmentValues, model.SecretEnvironmentValuesHash.ValueString()))
+
+	// no hash for non secret values
+	model = EnvironmentVariableResourceModel{
+		EnvironmentValues: types.MapValueMust(
+			types.StringType,
+			map[string]attr.Value{"project": types.StringValue("value")},
+		),
+		SecretEnvironmentValues: types.MapNull(types.StringType),
+	}
+	assert.False(t, setSecretEnvironmentValuesHash(&model, map[string]string{"project": "value"}).HasError())
+	assert.True(t, model.SecretEnvironmentValuesHash.IsNull())
+}
+
+func TestIsFullyKnown(t *testing.T) {
+	assert.True(t, isFullyKnown(types.MapValueMust(
+		types.StringType,
+		map[string]attr.Value{"project": types.StringValue("value")},
+	)))
+	assert.False(t, isFullyKnown(types.MapUnknown(types.StringType)))
+	assert.False(t, isFullyKnown(types.MapValueMust(
+		types.StringType,
+		map[string]attr.Value{"project": types.StringUnknown()},
+	)))
+	assert.False(t, isFullyKnown(types.MapValueMust(
+		types.MapType{ElemType: types.StringType},
+		map[string]attr.Value{"DBT_ENV_SECRET_TOKEN": types.MapValueMust(
+			types.StringType,
+			map[string]attr.Value{"project": types.StringUnknown()},
+		)},
+	)))
+}
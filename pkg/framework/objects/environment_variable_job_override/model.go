@@ -12,5 +12,7 @@ type EnvironmentVariableJobOverrideResourceModel struct {
 	Name                             types.String `tfsdk:"name"`
 	JobDefinitionID                  types.Int64  `tfsdk:"job_definition_id"`
 	RawValue                         types.String `tfsdk:"raw_value"`
+	SecretRawValue                   types.String `tfsdk:"secret_raw_value"`
+	SecretRawValueHash               types.String `tfsdk:"secret_raw_value_hash"`
 	EnvironmentVariableJobOverrideID types.Int64  `tfsdk:"environment_variable_job_override_id"`
 }
@@ -16,9 +16,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &environmentVariableJobOverrideResource{}
-	_ resource.ResourceWithConfigure   = &environmentVariableJobOverrideResource{}
-	_ resource.ResourceWithImportState = &environmentVariableJobOverrideResource{}
+	_ resource.Resource                   = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithConfigure      = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithImportState    = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithValidateConfig = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithModifyPlan     = &environmentVariableJobOverrideResource{}
 )
 
 // EnvironmentVariableJobOverrideResource is a helper function to simplify the provider implementation.
@@ -90,7 +92,11 @@ func (r *environmentVariableJobOverrideResource) Create(
 
 	projectID := int(plan.ProjectID.ValueInt64())
 	name := plan.Name.ValueString()
-	rawValue := plan.RawValue.ValueString()
+	rawValue, diags := getRawValue(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	jobDefinitionID := int(plan.JobDefinitionID.ValueInt64())
 
 	// Create new envVar
@@ -122,6 +128,11 @@ func (r *environmentVariableJobOverrideResource) Create(
 	plan.AccountID = types.Int64Value(int64(environmentVariableJobOverride.AccountID))
 	plan.EnvironmentVariableJobOverrideID = types.Int64Value(int64(*environmentVariableJobOverride.ID))
 
+	resp.Diagnostics.Append(setSecretRawValueHash(&plan, rawValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -211,13 +222,19 @@ func (r *environmentVariableJobOverrideResource) Update(
 	projectID := int(plan.ProjectID.ValueInt64())
 	id := plan.EnvironmentVariableJobOverrideID.ValueInt64()
 
+	rawValue, diags := getRawValue(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	envVarJobOverride := dbt_cloud.EnvironmentVariableJobOverride{
 		ProjectID:       projectID,
 		AccountID:       int(state.AccountID.ValueInt64()),
 		Name:            plan.Name.ValueString(),
 		ID:              helper.Int64ToIntPointer(id),
 		JobDefinitionID: int(plan.JobDefinitionID.ValueInt64()),
-		RawValue:        plan.RawValue.ValueString(),
+		RawValue:        rawValue,
 		Type:            "job",
 	}
 
@@ -232,6 +249,11 @@ func (r *environmentVariableJobOverrideResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(setSecretRawValueHash(&plan, rawValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -362,6 +384,11 @@ func (r *environmentVariableJobOverrideResource) ImportState(
 		envVarJobOverride.Name,
 	)...)
 
+	// the values of secret environment variables are masked by the API
+	if helper.IsSecretEnvironmentVariable(envVarJobOverride.Name) {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(
 		ctx,
 		path.Root("raw_value"),
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDbtCloudEnvironmentVariableJobOverrideResource(t *testing.T) {
@@ -42,6 +43,128 @@ func TestAccDbtCloudEnvironmentVariableJobOverrideResource(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudEnvironmentVariableJobOverrideResourceWriteOnlySecret(t *testing.T) {
+
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentVariableName := strings.ToUpper(
+		acctest.RandStringFromCharSet(10, acctest.CharSetAlpha),
+	)
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		// write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceSecretConfig(
+					projectName,
+					environmentName,
+					environmentVariableName,
+					jobName,
+					"Baa",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(
+						"dbtcloud_environment_variable_job_override.test_env_var_job_override",
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_environment_variable_job_override.test_env_var_job_override",
+						"raw_value",
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_environment_variable_job_override.test_env_var_job_override",
+						"secret_raw_value",
+					),
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_environment_variable_job_override.test_env_var_job_override",
+						"secret_raw_value_hash",
+					),
+				),
+			},
+			// the masked value returned by the API doesn't generate a diff
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceSecretConfig(
+					projectName,
+					environmentName,
+					environmentVariableName,
+					jobName,
+					"Baa",
+				),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceSecretConfig(
+					projectName,
+					environmentName,
+					environmentVariableName,
+					jobName,
+					"Oink",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_environment_variable_job_override.test_env_var_job_override",
+						"secret_raw_value_hash",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariableJobOverrideResourceSecretConfig(
+	projectName, environmentName, environmentVariableName, jobName, secretValue string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name        = "DBT_ENV_SECRET_%s"
+  project_id = dbtcloud_project.test_project.id
+  secret_environment_values = {
+    "project": "Baa"
+  }
+  depends_on = [
+    dbtcloud_project.test_project,
+    dbtcloud_environment.test_env
+  ]
+}
+
+resource dbtcloud_job test_job_sched {
+  environment_id = dbtcloud_environment.test_env.environment_id
+  execute_steps = ["dbt test"]
+  name = "%s"
+  project_id = dbtcloud_project.test_project.id
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false
+  }
+}
+
+resource dbtcloud_environment_variable_job_override test_env_var_job_override {
+	job_definition_id = dbtcloud_job.test_job_sched.id
+	project_id = dbtcloud_project.test_project.id
+	name = dbtcloud_environment_variable.test_env_var.name
+	secret_raw_value = "%s"
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, environmentVariableName, jobName, secretValue)
+}
+
 func getImportStateTestStep() resource.TestStep {
 	return resource.TestStep{
 		ResourceName:            "dbtcloud_environment_variable_job_override.test_env_var_job_override",
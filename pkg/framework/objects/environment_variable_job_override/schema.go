@@ -1,14 +1,17 @@
 package environment_variable_job_override
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var resourceSchema = resource_schema.Schema{
-	Description: "Environment variable job override resource",
+	Description: "Environment variable job override resource. To manage all the overrides of a job at once, use `env_var_overrides` in `dbtcloud_job` instead, but don't use both for the same job",
 	Attributes: map[string]resource_schema.Attribute{
 		"id": resource_schema.StringAttribute{
 			Computed:    true,
@@ -46,8 +49,21 @@ var resourceSchema = resource_schema.Schema{
 			},
 		},
 		"raw_value": resource_schema.StringAttribute{
-			Required:    true,
-			Description: "The value for the override of the environment variable",
+			Optional:    true,
+			Description: "The value for the override of the environment variable. This field is not set as sensitive so use `secret_raw_value` instead for secret environment variables.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("secret_raw_value")),
+			},
+		},
+		"secret_raw_value": resource_schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "Write-only value for the override of a secret environment variable, prefixed with `DBT_ENV_SECRET_`. The value is never stored in the state, only a salted hash is kept to detect changes. Requires Terraform 1.11 or later.",
+		},
+		"secret_raw_value_hash": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "Salted hash of `secret_raw_value`, used to detect changes of the secret value in the config",
 		},
 		"name": resource_schema.StringAttribute{
 			Required:    true,
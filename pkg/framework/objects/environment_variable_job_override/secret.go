@@ -0,0 +1,128 @@
+package environment_variable_job_override
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getRawValue returns the value of the override from `raw_value`
+// or, for secret environment variables, from the write-only `secret_raw_value` which is only available in the config
+func getRawValue(
+	ctx context.Context,
+	plan EnvironmentVariableJobOverrideResourceModel,
+	config tfsdk.Config,
+) (string, diag.Diagnostics) {
+	if !plan.RawValue.IsNull() {
+		return plan.RawValue.ValueString(), nil
+	}
+
+	var secretRawValue types.String
+	diags := config.GetAttribute(ctx, path.Root("secret_raw_value"), &secretRawValue)
+	return secretRawValue.ValueString(), diags
+}
+
+// setSecretRawValueHash stores the hash of the secret value in the model, instead of the value itself
+func setSecretRawValueHash(
+	model *EnvironmentVariableJobOverrideResourceModel,
+	rawValue string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.SecretRawValue = types.StringNull()
+	if !model.RawValue.IsNull() {
+		model.SecretRawValueHash = types.StringNull()
+		return diags
+	}
+
+	hash, err := helper.HashSecret(rawValue)
+	if err != nil {
+		diags.AddError("Error hashing the secret value", err.Error())
+		return diags
+	}
+	model.SecretRawValueHash = types.StringValue(hash)
+	return diags
+}
+
+func (r *environmentVariableJobOverrideResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config EnvironmentVariableJobOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Name.IsUnknown() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	isSecret := helper.IsSecretEnvironmentVariable(name)
+
+	if !config.SecretRawValue.IsNull() && !isSecret {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_raw_value"),
+			"Invalid secret environment variable",
+			fmt.Sprintf(
+				"`secret_raw_value` can only be used for variables prefixed with %s, use `raw_value` for %s.",
+				helper.SecretEnvironmentVariablePrefix,
+				name,
+			),
+		)
+	}
+
+	if !config.RawValue.IsNull() && isSecret {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("raw_value"),
+			"Secret value stored in the state",
+			fmt.Sprintf(
+				"%s is a secret environment variable but its override is set in `raw_value` and is stored in plain text in the state. "+
+					"Use `secret_raw_value` instead to only store a hash of the value.",
+				name,
+			),
+		)
+	}
+}
+
+// ModifyPlan detects the changes of `secret_raw_value` by comparing the value in the config with the hash in the state,
+// as the write-only value is never in the plan or the state and the secret values returned by the API are masked
+func (r *environmentVariableJobOverrideResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var secretRawValue types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_raw_value"), &secretRawValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if secretRawValue.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_raw_value_hash"), types.StringNull())...)
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !secretRawValue.IsUnknown() {
+		var stateHash types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret_raw_value_hash"), &stateHash)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !stateHash.IsNull() && helper.SecretMatchesHash(secretRawValue.ValueString(), stateHash.ValueString()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_raw_value_hash"), stateHash)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_raw_value_hash"), types.StringUnknown())...)
+}
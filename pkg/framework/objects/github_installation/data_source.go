@@ -0,0 +1,76 @@
+package github_installation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &gitHubInstallationDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitHubInstallationDataSource{}
+)
+
+func GitHubInstallationDataSource() datasource.DataSource {
+	return &gitHubInstallationDataSource{}
+}
+
+type gitHubInstallationDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *gitHubInstallationDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_github_installation"
+}
+
+func (d *gitHubInstallationDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state GitHubInstallationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	organization := state.Organization.ValueString()
+
+	gitHubInstallation, err := d.client.GetGitHubInstallation(organization)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Did not find GitHub App installation for organization: %s", organization),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(gitHubInstallation.ID)
+	state.AccountType = types.StringValue(gitHubInstallation.Account.Type)
+	state.HTMLURL = types.StringValue(gitHubInstallation.HTMLURL)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *gitHubInstallationDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError(
+			"Missing client",
+			"A client is required to configure the GitHub installation data source",
+		)
+	}
+}
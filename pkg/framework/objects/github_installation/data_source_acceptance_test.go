@@ -0,0 +1,59 @@
+package github_installation_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudGitHubInstallation(t *testing.T) {
+	personalAccessToken := acctest_config.AcceptanceTestConfig.DbtCloudPersonalAccessToken
+	if personalAccessToken == "" {
+		t.Skip("Skipping GitHub installation datasource because no personal access token is available")
+	}
+
+	installationID := acctest_config.AcceptanceTestConfig.GitHubAppInstallationId
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest_helper.TestAccPreCheck(t) },
+
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigVariables: config.Variables{
+					"dbt_token": config.StringVariable(personalAccessToken),
+				},
+				Config: `
+					variable "dbt_token" {
+						type = string
+						sensitive = true
+					}
+
+					provider "dbtcloud" {
+						token = var.dbt_token
+					}
+
+					data dbtcloud_github_installation test {
+						organization = "dbt-labs"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_github_installation.test",
+						"id",
+						strconv.Itoa(installationID),
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_github_installation.test",
+						"account_type",
+						"Organization",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,10 @@
+package github_installation
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type GitHubInstallationDataSourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	AccountType  types.String `tfsdk:"account_type"`
+	HTMLURL      types.String `tfsdk:"html_url"`
+}
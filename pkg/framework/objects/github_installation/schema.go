@@ -0,0 +1,39 @@
+package github_installation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *gitHubInstallationDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: `Use this data source to retrieve the ID of the dbt Cloud GitHub App installation 
+for a GitHub organization or user, to be used as ` + "`github_installation_id`" + ` in ` + "`dbtcloud_repository`" + `.
+		
+This data source requires connecting with a user token and doesn't work with a service token.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the GitHub App installation",
+			},
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the GitHub organization or user where the dbt Cloud GitHub App is installed (case insensitive)",
+			},
+			"account_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of GitHub account where the App is installed, `Organization` or `User`",
+			},
+			"html_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the GitHub App installation settings",
+			},
+		},
+	}
+}
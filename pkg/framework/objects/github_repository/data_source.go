@@ -0,0 +1,104 @@
+package github_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &gitHubRepositoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitHubRepositoryDataSource{}
+)
+
+func GitHubRepositoryDataSource() datasource.DataSource {
+	return &gitHubRepositoryDataSource{}
+}
+
+type gitHubRepositoryDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *gitHubRepositoryDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_github_repository"
+}
+
+func (d *gitHubRepositoryDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state GitHubRepositoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	fullName := state.FullName.ValueString()
+
+	organization, _, found := strings.Cut(fullName, "/")
+	if !found {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid GitHub repository full name: %s", fullName),
+			"The full name needs to include the organization, e.g. `my-org/my-repo`",
+		)
+		return
+	}
+
+	installationID := state.GithubInstallationID.ValueInt64()
+	if state.GithubInstallationID.IsNull() {
+		gitHubInstallation, err := d.client.GetGitHubInstallation(organization)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Did not find GitHub App installation for organization: %s", organization),
+				err.Error(),
+			)
+			return
+		}
+		installationID = gitHubInstallation.ID
+	}
+
+	gitHubRepository, err := d.client.GetGitHubRepository(fullName, installationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get GitHub repository %s in installation %d", fullName, installationID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(gitHubRepository.ID)
+	state.GithubInstallationID = types.Int64Value(installationID)
+	state.Name = types.StringValue(gitHubRepository.Name)
+	state.Private = types.BoolValue(gitHubRepository.Private)
+	state.HTMLURL = types.StringValue(gitHubRepository.HTMLURL)
+	state.CloneURL = types.StringValue(gitHubRepository.CloneURL)
+	state.SSHURL = types.StringValue(gitHubRepository.SSHURL)
+	state.DefaultBranch = types.StringValue(gitHubRepository.DefaultBranch)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *gitHubRepositoryDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError(
+			"Missing client",
+			"A client is required to configure the GitHub repository data source",
+		)
+	}
+}
@@ -0,0 +1,64 @@
+package github_repository_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudGitHubRepository(t *testing.T) {
+	personalAccessToken := acctest_config.AcceptanceTestConfig.DbtCloudPersonalAccessToken
+	if personalAccessToken == "" {
+		t.Skip("Skipping GitHub repository datasource because no personal access token is available")
+	}
+
+	installationID := acctest_config.AcceptanceTestConfig.GitHubAppInstallationId
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest_helper.TestAccPreCheck(t) },
+
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigVariables: config.Variables{
+					"dbt_token":              config.StringVariable(personalAccessToken),
+					"github_installation_id": config.IntegerVariable(installationID),
+				},
+				Config: `
+					variable "dbt_token" {
+						type = string
+						sensitive = true
+					}
+
+					provider "dbtcloud" {
+						token = var.dbt_token
+					}
+
+					variable "github_installation_id" {
+						type = number
+					}
+
+					data dbtcloud_github_repository test {
+						full_name = "dbt-labs/jaffle_shop"
+						github_installation_id = var.github_installation_id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_github_repository.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_github_repository.test",
+						"github_installation_id",
+						strconv.Itoa(installationID),
+					),
+					resource.TestCheckResourceAttr("data.dbtcloud_github_repository.test", "name", "jaffle_shop"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_github_repository.test", "ssh_url"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_github_repository.test", "default_branch"),
+				),
+			},
+		},
+	})
+}
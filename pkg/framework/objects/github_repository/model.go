@@ -0,0 +1,15 @@
+package github_repository
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type GitHubRepositoryDataSourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	FullName             types.String `tfsdk:"full_name"`
+	GithubInstallationID types.Int64  `tfsdk:"github_installation_id"`
+	Name                 types.String `tfsdk:"name"`
+	Private              types.Bool   `tfsdk:"private"`
+	HTMLURL              types.String `tfsdk:"html_url"`
+	CloneURL             types.String `tfsdk:"clone_url"`
+	SSHURL               types.String `tfsdk:"ssh_url"`
+	DefaultBranch        types.String `tfsdk:"default_branch"`
+}
@@ -0,0 +1,60 @@
+package github_repository
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *gitHubRepositoryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: `Use this data source to retrieve the ID and details of a GitHub repository 
+the dbt Cloud GitHub App has access to, based on its full name.
+		
+This data source requires connecting with a user token and doesn't work with a service token.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The GitHub ID of the repository",
+			},
+			"full_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The full name of the GitHub repository, including its organization, e.g. `my-org/my-repo` (case insensitive)",
+			},
+			"github_installation_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the GitHub App installation having access to the repository. Defaults to the installation of the organization of the repository",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the GitHub repository, without its organization",
+			},
+			"private": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the GitHub repository is private",
+			},
+			"html_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the GitHub repository accessible in the browser",
+			},
+			"clone_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The HTTPS URL to clone the GitHub repository",
+			},
+			"ssh_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SSH URL to clone the GitHub repository",
+			},
+			"default_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The default branch of the GitHub repository",
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package gitlab_project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &gitLabProjectDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitLabProjectDataSource{}
+)
+
+func GitLabProjectDataSource() datasource.DataSource {
+	return &gitLabProjectDataSource{}
+}
+
+type gitLabProjectDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *gitLabProjectDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_gitlab_project"
+}
+
+func (d *gitLabProjectDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state GitLabProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	pathWithNamespace := state.PathWithNamespace.ValueString()
+
+	gitLabProject, err := d.client.GetGitLabProject(pathWithNamespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Did not find GitLab project with path: %s", pathWithNamespace),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(gitLabProject.ID)
+	state.Name = types.StringValue(gitLabProject.Name)
+	state.HTTPURLToRepo = types.StringValue(gitLabProject.HTTPURLToRepo)
+	state.SSHURLToRepo = types.StringValue(gitLabProject.SSHURLToRepo)
+	state.WebURL = types.StringValue(gitLabProject.WebURL)
+	state.DefaultBranch = types.StringValue(gitLabProject.DefaultBranch)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *gitLabProjectDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		d.client = c
+	default:
+		resp.Diagnostics.AddError(
+			"Missing client",
+			"A client is required to configure the GitLab project data source",
+		)
+	}
+}
@@ -0,0 +1,61 @@
+package gitlab_project_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudGitLabProject(t *testing.T) {
+	//TODO: Remove the env var check when a GitLab integration gets configured in CI
+	gitLabProjectPath := os.Getenv("ACC_TEST_GITLAB_PROJECT_PATH")
+	if gitLabProjectPath == "" {
+		t.Skip("Skipping GitLab project datasource test until a GitLab integration is available")
+	}
+
+	personalAccessToken := acctest_config.AcceptanceTestConfig.DbtCloudPersonalAccessToken
+	if personalAccessToken == "" {
+		t.Skip("Skipping GitLab project datasource because no personal access token is available")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest_helper.TestAccPreCheck(t) },
+
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigVariables: config.Variables{
+					"dbt_token":           config.StringVariable(personalAccessToken),
+					"gitlab_project_path": config.StringVariable(gitLabProjectPath),
+				},
+				Config: `
+					variable "dbt_token" {
+						type = string
+						sensitive = true
+					}
+
+					provider "dbtcloud" {
+						token = var.dbt_token
+					}
+
+					variable "gitlab_project_path" {
+						type = string
+					}
+
+					data dbtcloud_gitlab_project test {
+						path_with_namespace = var.gitlab_project_path
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dbtcloud_gitlab_project.test", "id"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_gitlab_project.test", "ssh_url_to_repo"),
+					resource.TestCheckResourceAttrSet("data.dbtcloud_gitlab_project.test", "web_url"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,13 @@
+package gitlab_project
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type GitLabProjectDataSourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	PathWithNamespace types.String `tfsdk:"path_with_namespace"`
+	Name              types.String `tfsdk:"name"`
+	HTTPURLToRepo     types.String `tfsdk:"http_url_to_repo"`
+	SSHURLToRepo      types.String `tfsdk:"ssh_url_to_repo"`
+	WebURL            types.String `tfsdk:"web_url"`
+	DefaultBranch     types.String `tfsdk:"default_branch"`
+}
@@ -0,0 +1,51 @@
+package gitlab_project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (d *gitLabProjectDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: `Use this data source to retrieve the ID of a GitLab project based on its path, 
+to be used as ` + "`gitlab_project_id`" + ` in ` + "`dbtcloud_repository`" + `.
+		
+This data source requires connecting with a user token and doesn't work with a service token.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The GitLab ID of the project",
+			},
+			"path_with_namespace": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the GitLab project, including its groups, e.g. `my-group/my-project` (case insensitive)",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the GitLab project",
+			},
+			"http_url_to_repo": schema.StringAttribute{
+				Computed:    true,
+				Description: "The HTTPS URL to clone the GitLab project",
+			},
+			"ssh_url_to_repo": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SSH URL to clone the GitLab project",
+			},
+			"web_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the GitLab project accessible in the browser",
+			},
+			"default_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The default branch of the GitLab project",
+			},
+		},
+	}
+}
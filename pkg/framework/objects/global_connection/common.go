@@ -8,10 +8,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// readGeneric reads the connection in the state. The type of connection is taken from the state or from the adapter.
+// refreshAll is set for imports and data sources, whose state doesn't say yet which optional attributes are managed,
+// to read all of them.
 func readGeneric(
 	client *dbt_cloud.Client,
 	state *GlobalConnectionResourceModel,
 	adapter string,
+	refreshAll bool,
 ) (*GlobalConnectionResourceModel, string, error) {
 
 	connectionID := state.ID.ValueInt64()
@@ -25,7 +29,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SnowflakeConfig](client)
 
-		common, snowflakeCfg, err := c.Get(connectionID)
+		common, snowflakeCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -35,7 +39,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(snowflakeCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -220,7 +224,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.DatabricksConfig](client)
 
-		common, databricksCfg, err := c.Get(connectionID)
+		common, databricksCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -230,7 +234,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(databricksCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -268,7 +272,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](client)
 
-		common, redshiftCfg, err := c.Get(connectionID)
+		common, redshiftCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -281,9 +285,13 @@ func readGeneric(
 			return nil, "", err
 		}
 
+		// when the connection is managed without ssh_tunnel, the SSH tunnel can be managed with the dbtcloud_ssh_tunnel resource
+		// and is not added to the state, it is only read for imports and data sources
+		readSSHTunnel := state.RedshiftConfig.SSHTunnel != nil || refreshAll
+
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(redshiftCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -311,7 +319,7 @@ func readGeneric(
 		}
 
 		// SSH tunnel settings
-		if len(*sshTunnel) > 0 {
+		if readSSHTunnel && len(*sshTunnel) > 0 {
 
 			state.RedshiftConfig.SSHTunnel = &SSHTunnelConfig{
 				ID:        types.Int64PointerValue((*sshTunnel)[0].ID),
@@ -333,7 +341,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.PostgresConfig](client)
 
-		common, postgresCfg, err := c.Get(connectionID)
+		common, postgresCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -346,9 +354,13 @@ func readGeneric(
 			return nil, "", err
 		}
 
+		// when the connection is managed without ssh_tunnel, the SSH tunnel can be managed with the dbtcloud_ssh_tunnel resource
+		// and is not added to the state, it is only read for imports and data sources
+		readSSHTunnel := state.PostgresConfig.SSHTunnel != nil || refreshAll
+
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(postgresCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -376,7 +388,7 @@ func readGeneric(
 		}
 
 		// SSH tunnel settings
-		if len(*sshTunnel) > 0 {
+		if readSSHTunnel && len(*sshTunnel) > 0 {
 
 			state.PostgresConfig.SSHTunnel = &SSHTunnelConfig{
 				ID:        types.Int64PointerValue((*sshTunnel)[0].ID),
@@ -398,7 +410,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.FabricConfig](client)
 
-		common, fabricCfg, err := c.Get(connectionID)
+		common, fabricCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -408,7 +420,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(fabricCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -443,7 +455,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SynapseConfig](client)
 
-		common, synapseCfg, err := c.Get(connectionID)
+		common, synapseCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -453,7 +465,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(synapseCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -488,7 +500,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.StarburstConfig](client)
 
-		common, starburstCfg, err := c.Get(connectionID)
+		common, starburstCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -498,7 +510,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(starburstCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -530,7 +542,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.AthenaConfig](client)
 
-		common, athenaCfg, err := c.Get(connectionID)
+		common, athenaCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -540,7 +552,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(athenaCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -625,7 +637,7 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.ApacheSparkConfig](client)
 
-		common, sparkCfg, err := c.Get(connectionID)
+		common, sparkCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -635,7 +647,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(sparkCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -687,7 +699,7 @@ func readGeneric(
 		}
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.TeradataConfig](client)
-		common, teradataCfg, err := c.Get(connectionID)
+		common, teradataCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
 		if err != nil {
 			if strings.HasPrefix(err.Error(), "resource-not-found") {
 				return nil, "removeFromState", nil
@@ -697,7 +709,7 @@ func readGeneric(
 
 		// global settings
 		state.ID = types.Int64PointerValue(common.ID)
-		state.AdapterVersion = types.StringValue(teradataCfg.AdapterVersion())
+		state.AdapterVersion = types.StringValue(adapterVersion)
 		state.Name = types.StringPointerValue(common.Name)
 		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
 
@@ -708,6 +720,56 @@ func readGeneric(
 		state.TeradataConfig.RequestTimeout = types.Int64PointerValue(teradataCfg.RequestTimeout)
 		state.TeradataConfig.Retries = types.Int64PointerValue(teradataCfg.Retries)
 
+	// the adapters without a dedicated config, in the resource the adapter is empty
+	case state.CustomConfig != nil || adapter != "":
+		// in case we use it for a datasource, we need to set the Config to not be nil
+		if state.CustomConfig == nil {
+			state.CustomConfig = &CustomConfig{}
+		}
+
+		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.CustomConfig](client)
+
+		common, customCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "resource-not-found") {
+				return nil, "removeFromState", nil
+			}
+			return nil, "", err
+		}
+
+		// global settings
+		state.ID = types.Int64PointerValue(common.ID)
+		state.AdapterVersion = types.StringValue(adapterVersion)
+		state.Name = types.StringPointerValue(common.Name)
+		state.IsSshTunnelEnabled = types.BoolPointerValue(common.IsSshTunnelEnabled)
+
+		// nullable common fields
+		if !common.PrivateLinkEndpointId.IsNull() {
+			state.PrivateLinkEndpointId = types.StringValue(common.PrivateLinkEndpointId.MustGet())
+		} else {
+			state.PrivateLinkEndpointId = types.StringNull()
+		}
+		if !common.OauthConfigurationId.IsNull() {
+			state.OauthConfigurationId = types.Int64Value(common.OauthConfigurationId.MustGet())
+		} else {
+			state.OauthConfigurationId = types.Int64Null()
+		}
+
+		// custom settings
+		state.CustomConfig.AdapterVersion = types.StringValue(adapterVersion)
+		config, err := refreshCustomConfig(
+			state.CustomConfig.Config,
+			state.CustomConfig.SensitiveConfig,
+			*customCfg,
+			refreshAll,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+		state.CustomConfig.Config = config
+
+		// We don't set the sensitive config when we read because it is secret and never returned by the API
+
 	default:
 		panic("Unknown connection type")
 	}
@@ -0,0 +1,105 @@
+package global_connection
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseCustomConfig parses a JSON object from the custom config, a null value returns an empty map
+func parseCustomConfig(value types.String) (map[string]any, error) {
+	config := map[string]any{}
+	if value.IsNull() || value.IsUnknown() {
+		return config, nil
+	}
+
+	err := json.Unmarshal([]byte(value.ValueString()), &config)
+	if err != nil {
+		return nil, fmt.Errorf("the value must be a JSON object: %w", err)
+	}
+	return config, nil
+}
+
+// customConfigPayload merges config and sensitive_config in the config sent to dbt Cloud.
+// The keys removed since the previous state are sent as null so that they are removed when the connection is updated.
+func customConfigPayload(plan *CustomConfig, state *CustomConfig) (dbt_cloud.CustomConfig, error) {
+	payload := dbt_cloud.CustomConfig{}
+
+	if state != nil {
+		for _, value := range []types.String{state.Config, state.SensitiveConfig} {
+			previousConfig, err := parseCustomConfig(value)
+			if err != nil {
+				return nil, err
+			}
+			for key := range previousConfig {
+				payload[key] = nil
+			}
+		}
+	}
+
+	for _, value := range []types.String{plan.Config, plan.SensitiveConfig} {
+		config, err := parseCustomConfig(value)
+		if err != nil {
+			return nil, err
+		}
+		for key, keyValue := range config {
+			payload[key] = keyValue
+		}
+	}
+
+	return payload, nil
+}
+
+// refreshCustomConfig returns the config to save in the state from the one returned by dbt Cloud.
+// Only the keys already in the state are refreshed, as dbt Cloud returns the default values of the other ones,
+// unless refreshAll is set, e.g. after an import. The keys of the sensitive config are never added to the config.
+// The current value is kept when it is equivalent, so that formatting differences don't show as drift.
+func refreshCustomConfig(
+	stateConfig types.String,
+	stateSensitiveConfig types.String,
+	remoteConfig dbt_cloud.CustomConfig,
+	refreshAll bool,
+) (types.String, error) {
+	currentConfig, err := parseCustomConfig(stateConfig)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	sensitiveConfig, err := parseCustomConfig(stateSensitiveConfig)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	refreshedConfig := map[string]any{}
+	if refreshAll {
+		for key, value := range remoteConfig {
+			if _, ok := sensitiveConfig[key]; !ok && value != nil {
+				refreshedConfig[key] = value
+			}
+		}
+	} else {
+		for key := range currentConfig {
+			if _, ok := sensitiveConfig[key]; ok {
+				continue
+			}
+			if value, ok := remoteConfig[key]; ok {
+				refreshedConfig[key] = value
+			}
+		}
+	}
+
+	if stateConfig.IsNull() && len(refreshedConfig) == 0 {
+		return types.StringNull(), nil
+	}
+	if !stateConfig.IsNull() && reflect.DeepEqual(currentConfig, refreshedConfig) {
+		return stateConfig, nil
+	}
+
+	configJSON, err := json.Marshal(refreshedConfig)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(configJSON)), nil
+}
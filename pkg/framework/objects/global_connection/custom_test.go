@@ -0,0 +1,123 @@
+package global_connection
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCustomConfig(t *testing.T) {
+	config, err := parseCustomConfig(types.StringNull())
+	assert.NoError(t, err)
+	assert.Empty(t, config)
+
+	config, err = parseCustomConfig(types.StringValue(`{"host": "localhost", "port": 8443}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"host": "localhost", "port": float64(8443)}, config)
+
+	_, err = parseCustomConfig(types.StringValue(`["host"]`))
+	assert.ErrorContains(t, err, "the value must be a JSON object")
+}
+
+func TestCustomConfigPayload(t *testing.T) {
+	plan := &CustomConfig{
+		AdapterVersion:  types.StringValue("clickhouse_v0"),
+		Config:          types.StringValue(`{"host": "localhost", "port": 8443}`),
+		SensitiveConfig: types.StringValue(`{"password": "secret"}`),
+	}
+
+	payload, err := customConfigPayload(plan, nil)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		dbt_cloud.CustomConfig{"host": "localhost", "port": float64(8443), "password": "secret"},
+		payload,
+	)
+
+	// the keys removed from the config are sent as null
+	state := &CustomConfig{
+		AdapterVersion:  types.StringValue("clickhouse_v0"),
+		Config:          types.StringValue(`{"host": "localhost", "secure": true}`),
+		SensitiveConfig: types.StringValue(`{"password": "old_secret", "token": "abc"}`),
+	}
+
+	payload, err = customConfigPayload(plan, state)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		dbt_cloud.CustomConfig{
+			"host":     "localhost",
+			"port":     float64(8443),
+			"secure":   nil,
+			"password": "secret",
+			"token":    nil,
+		},
+		payload,
+	)
+}
+
+func TestRefreshCustomConfig(t *testing.T) {
+	remoteConfig := dbt_cloud.CustomConfig{
+		"host":            "remote-host",
+		"port":            float64(8443),
+		"connect_timeout": float64(10),
+		"cluster":         nil,
+	}
+
+	noSensitiveConfig := types.StringNull()
+
+	// only the keys in the state are refreshed
+	config, err := refreshCustomConfig(
+		types.StringValue(`{"host": "localhost", "port": 8443}`),
+		noSensitiveConfig,
+		remoteConfig,
+		false,
+	)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"host": "remote-host", "port": 8443}`, config.ValueString())
+
+	// the value is kept as is when there is no drift
+	stateConfig := types.StringValue("{\n  \"port\": 8443,\n  \"host\": \"remote-host\"\n}")
+	config, err = refreshCustomConfig(stateConfig, noSensitiveConfig, remoteConfig, false)
+	assert.NoError(t, err)
+	assert.Equal(t, stateConfig, config)
+
+	// a key not returned anymore is removed
+	config, err = refreshCustomConfig(
+		types.StringValue(`{"host": "remote-host", "database": "db"}`),
+		noSensitiveConfig,
+		remoteConfig,
+		false,
+	)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"host": "remote-host"}`, config.ValueString())
+
+	// all the keys with a value are read after an import
+	config, err = refreshCustomConfig(types.StringNull(), noSensitiveConfig, remoteConfig, true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"host": "remote-host", "port": 8443, "connect_timeout": 10}`, config.ValueString())
+
+	config, err = refreshCustomConfig(types.StringNull(), noSensitiveConfig, dbt_cloud.CustomConfig{}, true)
+	assert.NoError(t, err)
+	assert.True(t, config.IsNull())
+}
+
+func TestRefreshCustomConfig_SensitiveConfigOnly(t *testing.T) {
+	remoteConfig := dbt_cloud.CustomConfig{
+		"host":  "remote-host",
+		"token": "**********",
+	}
+	sensitiveConfig := types.StringValue(`{"token": "secret"}`)
+
+	// when only sensitive_config is managed, config stays null instead of being filled with the values of dbt Cloud
+	config, err := refreshCustomConfig(types.StringNull(), sensitiveConfig, remoteConfig, false)
+	assert.NoError(t, err)
+	assert.True(t, config.IsNull())
+
+	// the keys of sensitive_config are never added to config, even when refreshing all the keys
+	config, err = refreshCustomConfig(types.StringNull(), sensitiveConfig, remoteConfig, true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"host": "remote-host"}`, config.ValueString())
+}
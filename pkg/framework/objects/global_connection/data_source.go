@@ -49,6 +49,7 @@ func (d *globalConnectionDataSource) Read(
 		d.client,
 		&state,
 		globalConnectionResponse.Data.AdapterVersion,
+		true,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading the connection", err.Error())
@@ -1,12 +1,14 @@
 package global_connection
 
 import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 )
 
 type ConfigDetails struct {
 	EmptyConfigName    interface{}
+	AdapterVersions    []string // the first version is the one used by default
 	IsEmptyConfig      func(*GlobalConnectionResourceModel) bool
 	GetSSHTunnelConfig func(*GlobalConnectionResourceModel) *SSHTunnelConfig
 }
@@ -14,6 +16,10 @@ type ConfigDetails struct {
 var mappingAdapterDetails = map[string]ConfigDetails{
 	"bigquery": {
 		EmptyConfigName: BigQueryConfig{},
+		AdapterVersions: []string{
+			dbt_cloud.BigQueryConfig{}.AdapterVersion(),
+			dbt_cloud.BigQueryConfig{}.LatestAdapterVersion(),
+		},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.BigQueryConfig == nil
 		},
@@ -23,6 +29,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"snowflake": {
 		EmptyConfigName: SnowflakeConfig{},
+		AdapterVersions: []string{dbt_cloud.SnowflakeConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.SnowflakeConfig == nil
 		},
@@ -32,6 +39,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"databricks": {
 		EmptyConfigName: DatabricksConfig{},
+		AdapterVersions: []string{dbt_cloud.DatabricksConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.DatabricksConfig == nil
 		},
@@ -41,6 +49,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"redshift": {
 		EmptyConfigName: RedshiftConfig{},
+		AdapterVersions: []string{dbt_cloud.RedshiftConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.RedshiftConfig == nil
 		},
@@ -54,6 +63,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"postgres": {
 		EmptyConfigName: PostgresConfig{},
+		AdapterVersions: []string{dbt_cloud.PostgresConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.PostgresConfig == nil
 		},
@@ -67,6 +77,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"fabric": {
 		EmptyConfigName: FabricConfig{},
+		AdapterVersions: []string{dbt_cloud.FabricConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.FabricConfig == nil
 		},
@@ -76,6 +87,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"synapse": {
 		EmptyConfigName: SynapseConfig{},
+		AdapterVersions: []string{dbt_cloud.SynapseConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.SynapseConfig == nil
 		},
@@ -85,6 +97,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"starburst": {
 		EmptyConfigName: StarburstConfig{},
+		AdapterVersions: []string{dbt_cloud.StarburstConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.StarburstConfig == nil
 		},
@@ -94,6 +107,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"athena": {
 		EmptyConfigName: AthenaConfig{},
+		AdapterVersions: []string{dbt_cloud.AthenaConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.AthenaConfig == nil
 		},
@@ -103,6 +117,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"apache_spark": {
 		EmptyConfigName: ApacheSparkConfig{},
+		AdapterVersions: []string{dbt_cloud.ApacheSparkConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.ApacheSparkConfig == nil
 		},
@@ -112,6 +127,7 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 	},
 	"teradata": {
 		EmptyConfigName: TeradataConfig{},
+		AdapterVersions: []string{dbt_cloud.TeradataConfig{}.AdapterVersion()},
 		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
 			return model.TeradataConfig == nil
 		},
@@ -119,10 +135,27 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 			return nil
 		},
 	},
+	"custom": {
+		EmptyConfigName: CustomConfig{},
+		// any adapter version is accepted, it is set in the custom config
+		AdapterVersions: nil,
+		IsEmptyConfig: func(model *GlobalConnectionResourceModel) bool {
+			return model.CustomConfig == nil
+		},
+		GetSSHTunnelConfig: func(model *GlobalConnectionResourceModel) *SSHTunnelConfig {
+			return nil
+		},
+	},
 }
 
 var supportedGlobalConfigTypes = lo.Keys(mappingAdapterDetails)
 
+// adapterVersionUpgrades lists the adapter versions that can be upgraded in place,
+// any other change of adapter version requires replacing the connection
+var adapterVersionUpgrades = map[string]string{
+	dbt_cloud.BigQueryConfig{}.AdapterVersion(): dbt_cloud.BigQueryConfig{}.LatestAdapterVersion(),
+}
+
 type GlobalConnectionResourceModel struct {
 	ID                    types.Int64        `tfsdk:"id"`
 	AdapterVersion        types.String       `tfsdk:"adapter_version"`
@@ -141,6 +174,7 @@ type GlobalConnectionResourceModel struct {
 	AthenaConfig          *AthenaConfig      `tfsdk:"athena"`
 	ApacheSparkConfig     *ApacheSparkConfig `tfsdk:"apache_spark"`
 	TeradataConfig        *TeradataConfig    `tfsdk:"teradata"`
+	CustomConfig          *CustomConfig      `tfsdk:"custom"`
 }
 
 type SSHTunnelConfig struct {
@@ -280,6 +314,13 @@ type TeradataConfig struct {
 	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
 }
 
+// CustomConfig is used for the adapters that don't have a dedicated config, the config is sent as is to dbt Cloud
+type CustomConfig struct {
+	AdapterVersion  types.String `tfsdk:"adapter_version"`
+	Config          types.String `tfsdk:"config"`
+	SensitiveConfig types.String `tfsdk:"sensitive_config"`
+}
+
 type GlobalConnectionsDatasourceModel struct {
 	Connections []GlobalConnectionSummary `tfsdk:"connections"`
 }
@@ -2,6 +2,7 @@ package global_connection
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,7 @@ var (
 	_ resource.ResourceWithImportState      = &globalConnectionResource{}
 	_ resource.ResourceWithConfigValidators = &globalConnectionResource{}
 	_ resource.ResourceWithModifyPlan       = &globalConnectionResource{}
+	_ resource.ResourceWithValidateConfig   = &globalConnectionResource{}
 )
 
 func GlobalConnectionResource() resource.Resource {
@@ -52,6 +54,76 @@ func (r globalConnectionResource) ConfigValidators(ctx context.Context) []resour
 			path.MatchRoot("bigquery"),
 			path.MatchRoot("private_link_endpoint_id"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("adapter_version"),
+			path.MatchRoot("bigquery").AtName("use_latest_adapter"),
+		),
+	}
+}
+
+func (r globalConnectionResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	for _, configPath := range []path.Path{
+		path.Root("custom").AtName("config"),
+		path.Root("custom").AtName("sensitive_config"),
+	} {
+		var configValue types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, configPath, &configValue)...)
+		if _, err := parseCustomConfig(configValue); err != nil {
+			resp.Diagnostics.AddAttributeError(configPath, "Invalid custom config", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var adapterVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adapter_version"), &adapterVersion)...)
+	if resp.Diagnostics.HasError() || adapterVersion.IsNull() || adapterVersion.IsUnknown() {
+		return
+	}
+
+	for configType, configDetails := range mappingAdapterDetails {
+		var config types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(configType), &config)...)
+		if config.IsNull() {
+			continue
+		}
+
+		if configType == "custom" {
+			var customAdapterVersion types.String
+			resp.Diagnostics.Append(
+				req.Config.GetAttribute(ctx, path.Root("custom").AtName("adapter_version"), &customAdapterVersion)...,
+			)
+			if !customAdapterVersion.IsUnknown() && !customAdapterVersion.Equal(adapterVersion) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("adapter_version"),
+					"Invalid adapter version",
+					fmt.Sprintf(
+						"The adapter version %q doesn't match the one of the custom config, %q",
+						adapterVersion.ValueString(),
+						customAdapterVersion.ValueString(),
+					),
+				)
+			}
+			continue
+		}
+
+		if !lo.Contains(configDetails.AdapterVersions, adapterVersion.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("adapter_version"),
+				"Invalid adapter version",
+				fmt.Sprintf(
+					"The adapter version %q is not supported for %s connections, supported versions are: %s",
+					adapterVersion.ValueString(),
+					configType,
+					strings.Join(configDetails.AdapterVersions, ", "),
+				),
+			)
+		}
 	}
 }
 
@@ -63,8 +135,8 @@ func (r globalConnectionResource) ModifyPlan(
 
 	var plan, state GlobalConnectionResourceModel
 
-	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
-		// we only check when both plan and state are not null
+	if req.Plan.Raw.IsNull() {
+		// nothing to plan when the connection is destroyed
 		return
 	}
 
@@ -75,22 +147,70 @@ func (r globalConnectionResource) ModifyPlan(
 		return
 	}
 
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	hasState := !req.State.Raw.IsNull()
+	if hasState {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for configType, configState := range mappingAdapterDetails {
+			wasNull := configState.IsEmptyConfig(&state)
+			isNull := configState.IsEmptyConfig(&plan)
+
+			if (wasNull && !isNull) ||
+				(!wasNull && isNull) {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root(configType))
+			}
+		}
+	}
+
+	configType, found := lo.FindKeyBy(
+		mappingAdapterDetails,
+		func(_ string, configDetails ConfigDetails) bool { return !configDetails.IsEmptyConfig(&plan) },
+	)
+	if !found {
+		// the config validators already raise an error when no adapter is configured
 		return
 	}
+	sameConfigType := hasState && !mappingAdapterDetails[configType].IsEmptyConfig(&state)
 
-	for configType, configState := range mappingAdapterDetails {
-		wasNull := configState.IsEmptyConfig(&state)
-		isNull := configState.IsEmptyConfig(&plan)
+	// when the adapter version is not set, we keep the current one, or use the default one for new connections
+	var configAdapterVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adapter_version"), &configAdapterVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configAdapterVersion.IsNull() {
+		switch {
+		case plan.CustomConfig != nil:
+			plan.AdapterVersion = plan.CustomConfig.AdapterVersion
+		case plan.BigQueryConfig != nil && plan.BigQueryConfig.UseLatestAdapter.ValueBool():
+			plan.AdapterVersion = types.StringValue(dbt_cloud.BigQueryConfig{}.LatestAdapterVersion())
+		case sameConfigType:
+			plan.AdapterVersion = state.AdapterVersion
+		default:
+			plan.AdapterVersion = types.StringValue(mappingAdapterDetails[configType].AdapterVersions[0])
+		}
+	}
 
-		if (wasNull && !isNull) ||
-			(!wasNull && isNull) {
-			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(configType))
+	isUpgrade := false
+	if sameConfigType && !plan.AdapterVersion.IsUnknown() && !plan.AdapterVersion.Equal(state.AdapterVersion) {
+		if adapterVersionUpgrades[state.AdapterVersion.ValueString()] == plan.AdapterVersion.ValueString() {
+			isUpgrade = true
+		} else {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("adapter_version"))
 		}
 	}
 
+	// bigquery_v1 doesn't support timeout_seconds, so when upgrading its value is migrated to job_execution_timeout_seconds
+	// if the latter is not set in the config
+	if isUpgrade && plan.BigQueryConfig != nil && plan.BigQueryConfig.JobExecutionTimeoutSeconds.IsUnknown() {
+		plan.BigQueryConfig.JobExecutionTimeoutSeconds = state.BigQueryConfig.TimeoutSeconds
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *globalConnectionResource) Read(
@@ -102,7 +222,13 @@ func (r *globalConnectionResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	newState, action, err := readGeneric(r.client, &state, "")
+	imported, diags := helper.IsImported(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, action, err := readGeneric(r.client, &state, "", imported)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading the connection", err.Error())
 		return
@@ -117,6 +243,7 @@ func (r *globalConnectionResource) Read(
 		return
 	}
 
+	resp.Diagnostics.Append(helper.ClearImported(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 
 }
@@ -200,7 +327,8 @@ func (r *globalConnectionResource) Create(
 		}
 
 		// nullable fields
-		if !plan.BigQueryConfig.JobExecutionTimeoutSeconds.IsNull() {
+		if !plan.BigQueryConfig.JobExecutionTimeoutSeconds.IsNull() &&
+			!plan.BigQueryConfig.JobExecutionTimeoutSeconds.IsUnknown() {
 			bigqueryCfg.JobExecutionTimeoutSeconds.Set(plan.BigQueryConfig.JobExecutionTimeoutSeconds.ValueInt64())
 		}
 
@@ -251,11 +379,11 @@ func (r *globalConnectionResource) Create(
 
 		var createdID int64
 		var adapterVersion string
-		if plan.BigQueryConfig.UseLatestAdapter.ValueBool() {
+		if plan.AdapterVersion.ValueString() != bigqueryCfg.AdapterVersion() {
 			payloadData, err := c.CreateWithLatestAdapter(
 				commonCfg,
 				bigqueryCfg,
-				bigqueryCfg.LatestAdapterVersion(),
+				plan.AdapterVersion.ValueString(),
 			)
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -284,7 +412,7 @@ func (r *globalConnectionResource) Create(
 		newState.BigQueryConfig.ApplicationSecret = plan.BigQueryConfig.ApplicationSecret
 		newState.AdapterVersion = types.StringValue(adapterVersion)
 
-		readState, action, err := readGeneric(r.client, &newState, adapterVersion)
+		readState, action, err := readGeneric(r.client, &newState, adapterVersion, false)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading the connection after creation", err.Error())
 			return
@@ -299,7 +427,7 @@ func (r *globalConnectionResource) Create(
 
 		readState.BigQueryConfig.UseLatestAdapter = plan.BigQueryConfig.UseLatestAdapter
 		// dragging this along so it doesn't break backwards compatibility
-		if plan.BigQueryConfig.UseLatestAdapter.ValueBool() {
+		if adapterVersion != bigqueryCfg.AdapterVersion() {
 			readState.BigQueryConfig.TimeoutSeconds = plan.BigQueryConfig.TimeoutSeconds
 		}
 
@@ -640,6 +768,31 @@ func (r *globalConnectionResource) Create(
 		plan.AdapterVersion = types.StringValue(teradaCfg.AdapterVersion())
 		plan.IsSshTunnelEnabled = types.BoolPointerValue(commonResp.IsSshTunnelEnabled)
 
+	case plan.CustomConfig != nil:
+
+		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.CustomConfig](r.client)
+
+		customCfg, err := customConfigPayload(plan.CustomConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating the connection", err.Error())
+			return
+		}
+
+		payloadData, err := c.CreateWithLatestAdapter(
+			commonCfg,
+			customCfg,
+			plan.CustomConfig.AdapterVersion.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating the connection", err.Error())
+			return
+		}
+
+		// we set the computed values that don't have any default
+		plan.ID = types.Int64PointerValue(payloadData.ID)
+		plan.AdapterVersion = plan.CustomConfig.AdapterVersion
+		plan.IsSshTunnelEnabled = types.BoolPointerValue(payloadData.IsSshTunnelEnabled)
+
 	default:
 		panic("Unknown connection type")
 	}
@@ -832,11 +985,6 @@ func (r *globalConnectionResource) Update(
 		if plan.BigQueryConfig.Retries != state.BigQueryConfig.Retries {
 			warehouseConfigChanges.Retries = plan.BigQueryConfig.Retries.ValueInt64Pointer()
 		}
-		if plan.BigQueryConfig.UseLatestAdapter != state.BigQueryConfig.UseLatestAdapter {
-			resp.Diagnostics.AddError("Error updating global connection", "Changing the adapter version is not supported.")
-				return
-		}
-
 		left, right := lo.Difference(plan.BigQueryConfig.Scopes, state.BigQueryConfig.Scopes)
 		if len(left) > 0 || len(right) > 0 {
 			warehouseConfigChanges.Scopes = helper.TypesStringSliceToStringSlice(
@@ -942,9 +1090,9 @@ func (r *globalConnectionResource) Update(
 		var updateCommon *dbt_cloud.GlobalConnectionCommon
 		var err error
 
-		// at this point we have updated the adapter version in the plan, so use it
-		var adapterVersion string
-		if !plan.BigQueryConfig.UseLatestAdapter.ValueBool() {
+		// the adapter version is set in the plan, and is upgraded in place when it changes
+		adapterVersion := plan.AdapterVersion.ValueString()
+		if adapterVersion == warehouseConfigChanges.AdapterVersion() {
 			updateCommon, _, err = c.Update(
 				state.ID.ValueInt64(),
 				globalConfigChanges,
@@ -954,19 +1102,17 @@ func (r *globalConnectionResource) Update(
 				resp.Diagnostics.AddError("Error updating global connection", err.Error())
 				return
 			}
-			adapterVersion = warehouseConfigChanges.AdapterVersion()
 		} else {
 			updateCommon, _, err = c.UpdateWithLatestAdapter(
 				state.ID.ValueInt64(),
 				globalConfigChanges,
 				warehouseConfigChanges,
-				warehouseConfigChanges.LatestAdapterVersion(),
+				adapterVersion,
 			)
 			if err != nil {
 				resp.Diagnostics.AddError("Error updating global connection", err.Error())
 				return
 			}
-			adapterVersion = warehouseConfigChanges.LatestAdapterVersion()
 		}
 
 		// we set the computed values, no need to do it for ID as we use a PlanModifier with UseStateForUnknown()
@@ -1469,6 +1615,32 @@ func (r *globalConnectionResource) Update(
 		plan.IsSshTunnelEnabled = types.BoolPointerValue(updateCommon.IsSshTunnelEnabled)
 		plan.AdapterVersion = types.StringValue(warehouseConfigChanges.AdapterVersion())
 
+	case plan.CustomConfig != nil:
+
+		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.CustomConfig](r.client)
+
+		// the whole config is sent, as we can't know which keys depend on each other
+		warehouseConfigChanges, err := customConfigPayload(plan.CustomConfig, state.CustomConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating global connection", err.Error())
+			return
+		}
+
+		updateCommon, _, err := c.UpdateWithLatestAdapter(
+			state.ID.ValueInt64(),
+			globalConfigChanges,
+			warehouseConfigChanges,
+			plan.CustomConfig.AdapterVersion.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating global connection", err.Error())
+			return
+		}
+
+		// we set the computed values, no need to do it for ID as we use a PlanModifier with UseStateForUnknown()
+		plan.IsSshTunnelEnabled = types.BoolPointerValue(updateCommon.IsSshTunnelEnabled)
+		plan.AdapterVersion = plan.CustomConfig.AdapterVersion
+
 	default:
 		panic("Unknown connection type")
 	}
@@ -1513,6 +1685,23 @@ func (r *globalConnectionResource) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(connectionID))...)
+	resp.Diagnostics.Append(helper.SetImported(ctx, resp.Private)...)
+
+	// the adapters without a dedicated config are imported in the custom config
+	if _, ok := mappingAdapterDetails[connectionType]; !ok || connectionType == "custom" {
+		resp.Diagnostics.Append(
+			resp.State.SetAttribute(
+				ctx,
+				path.Root("custom"),
+				CustomConfig{
+					AdapterVersion:  types.StringValue(globalConnectionResponse.Data.AdapterVersion),
+					Config:          types.StringNull(),
+					SensitiveConfig: types.StringNull(),
+				},
+			)...)
+		return
+	}
+
 	resp.Diagnostics.Append(
 		resp.State.SetAttribute(
 			ctx,
@@ -9,6 +9,7 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDbtCloudGlobalConnectionSnowflakeResource(t *testing.T) {
@@ -335,6 +336,7 @@ func TestAccDbtCloudGlobalConnectionBigQueryUpdateV1AdapterFromV0(t *testing.T)
 	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	jobExecutionTimeoutSeconds := int64(1000)
 	timeoutSeconds := int64(500)
+	var connectionID string
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
@@ -367,16 +369,28 @@ func TestAccDbtCloudGlobalConnectionBigQueryUpdateV1AdapterFromV0(t *testing.T)
 						"is_ssh_tunnel_enabled",
 						"false",
 					),
+					testAccCheckDbtCloudGlobalConnectionIDUnchanged("dbtcloud_global_connection.test", &connectionID),
 				),
 			},
-			// modify, adding optional fields
+			// upgrade in place, the ID stays the same
 			{
 				Config: testAccDbtCloudSGlobalConnectionBigQueryResourceBasicConfigWithJobExecutionTimeoutSeconds(
 					connectionName,
 					jobExecutionTimeoutSeconds,
 				),
-				// expect an error
-				ExpectError: regexp.MustCompile("Changing the adapter version is not supported."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"bigquery_v1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"bigquery.job_execution_timeout_seconds",
+						"1000",
+					),
+					testAccCheckDbtCloudGlobalConnectionIDUnchanged("dbtcloud_global_connection.test", &connectionID),
+				),
 			},
 
 			// IMPORT
@@ -389,7 +403,8 @@ func TestAccDbtCloudGlobalConnectionBigQueryUpdateV1AdapterFromV0(t *testing.T)
 					"bigquery.application_secret",
 					"bigquery.application_id",
 					"bigquery.timeout_seconds",
-					"bigquery.adapter_version_override",
+					"bigquery.job_execution_timeout_seconds",
+					"bigquery.use_latest_adapter",
 				},
 			},
 		},
@@ -397,6 +412,108 @@ func TestAccDbtCloudGlobalConnectionBigQueryUpdateV1AdapterFromV0(t *testing.T)
 
 }
 
+func TestAccDbtCloudGlobalConnectionBigQueryAdapterVersion(t *testing.T) {
+	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	var connectionID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudSGlobalConnectionBigQueryResourceAdapterVersionConfig(
+					connectionName,
+					"bigquery_v2",
+				),
+				ExpectError: regexp.MustCompile("supported versions are: bigquery_v0, bigquery_v1"),
+			},
+			{
+				Config: testAccDbtCloudSGlobalConnectionBigQueryResourceAdapterVersionConfig(
+					connectionName,
+					"bigquery_v0",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"bigquery_v0",
+					),
+					testAccCheckDbtCloudGlobalConnectionIDUnchanged("dbtcloud_global_connection.test", &connectionID),
+				),
+			},
+			// upgrading migrates timeout_seconds to job_execution_timeout_seconds
+			{
+				Config: testAccDbtCloudSGlobalConnectionBigQueryResourceAdapterVersionConfig(
+					connectionName,
+					"bigquery_v1",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"bigquery_v1",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"bigquery.job_execution_timeout_seconds",
+						"600",
+					),
+					testAccCheckDbtCloudGlobalConnectionIDUnchanged("dbtcloud_global_connection.test", &connectionID),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudSGlobalConnectionBigQueryResourceAdapterVersionConfig(
+	connectionName string,
+	adapterVersion string,
+) string {
+	return fmt.Sprintf(`
+
+resource dbtcloud_global_connection test {
+  name            = "%s"
+  adapter_version = "%s"
+
+  bigquery = {
+    gcp_project_id              = "70403103977025"
+    private_key_id              = "my-private-key-id"
+    private_key                 = "ABCDEFGHIJKL"
+    client_email                = "my_client_email"
+    client_id                   = "my_client_id"
+    auth_uri                    = "my_auth_uri"
+    token_uri                   = "my_token_uri"
+    auth_provider_x509_cert_url = "my_auth_provider_x509_cert_url"
+    client_x509_cert_url        = "my_client_x509_cert_url"
+    timeout_seconds             = 600
+  }
+}
+
+`, connectionName, adapterVersion)
+}
+
+// testAccCheckDbtCloudGlobalConnectionIDUnchanged records the ID of the connection the first time it is called,
+// and checks that it didn't change the next times, meaning that the connection was updated in place
+func testAccCheckDbtCloudGlobalConnectionIDUnchanged(
+	resourceName string,
+	connectionID *string,
+) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		if *connectionID == "" {
+			*connectionID = rs.Primary.ID
+			return nil
+		}
+		if rs.Primary.ID != *connectionID {
+			return fmt.Errorf("the connection was recreated, its ID changed from %s to %s", *connectionID, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccDbtCloudSGlobalConnectionBigQueryResourceBasicConfig(
 	connectionName string,
 	timeoutSeconds int64,
@@ -1635,3 +1752,76 @@ resource "dbtcloud_global_connection" test {
 
 `, connectionName)
 }
+
+func TestAccDbtCloudGlobalConnectionCustomResource(t *testing.T) {
+	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudSGlobalConnectionCustomResourceConfig(
+					connectionName,
+					`{ hostname = "my-postgres.example.com", port = 5432, dbname = "analytics" }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"adapter_version",
+						"postgres_v0",
+					),
+				),
+			},
+			// update a key and remove another one
+			{
+				Config: testAccDbtCloudSGlobalConnectionCustomResourceConfig(
+					connectionName,
+					`{ hostname = "my-postgres.example.com", port = 5433 }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"dbtcloud_global_connection.test",
+						"custom.config",
+						`{"hostname":"my-postgres.example.com","port":5433}`,
+					),
+				),
+			},
+			{
+				Config: `
+resource dbtcloud_global_connection test {
+  name            = "invalid"
+  adapter_version = "postgres_v1"
+  custom = {
+    adapter_version = "postgres_v0"
+    config          = "not json"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("the value must be a JSON object"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudSGlobalConnectionCustomResourceConfig(
+	connectionName string,
+	config string,
+) string {
+	return fmt.Sprintf(`
+
+resource dbtcloud_global_connection test {
+  name = "%s"
+
+  custom = {
+    adapter_version = "postgres_v0"
+    config          = jsonencode(%s)
+  }
+}
+
+`, connectionName, config)
+}
@@ -41,8 +41,9 @@ func (r *globalConnectionResource) Schema(
 				},
 			},
 			"adapter_version": resource_schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Version of the adapter",
+				Description: "Version of the adapter, e.g. `bigquery_v1` - Defaults to the first version supported by dbt Cloud for the adapter (`<adapter>_v0`). Changing it is done in place for the supported upgrade paths (`bigquery_v0` to `bigquery_v1`, where `timeout_seconds` is migrated to `job_execution_timeout_seconds`), any other change recreates the connection.",
 			},
 			"name": resource_schema.StringAttribute{
 				Required:    true,
@@ -75,6 +76,7 @@ func (r *globalConnectionResource) Schema(
 					},
 					"job_execution_timeout_seconds": resource_schema.Int64Attribute{
 						Optional:    true,
+						Computed:    true,
 						Description: "Timeout in seconds for job execution, to be used for the bigquery_v1 adapter",
 					},
 					"private_key_id": resource_schema.StringAttribute{
@@ -188,8 +190,9 @@ func (r *globalConnectionResource) Schema(
 						Description: "OAuth scopes for the BigQuery connection",
 					},
 					"use_latest_adapter": resource_schema.BoolAttribute{
-						Optional:    true,
-						Description: "Whether to use the latest bigquery_v1 adapter (use this for BQ WIF). If true, the `job_execution_timeout_seconds` field will be used. Warning! changing the adapter version (from legacy to latest or vice versa) is not supported.",
+						Optional:           true,
+						Description:        "Whether to use the latest bigquery_v1 adapter (use this for BQ WIF). If true, the `job_execution_timeout_seconds` field will be used. Setting it to true on an existing connection upgrades it in place, setting it back to false keeps the current adapter version.",
+						DeprecationMessage: "Use `adapter_version = \"bigquery_v1\"` instead",
 					},
 				},
 			},
@@ -602,6 +605,25 @@ func (r *globalConnectionResource) Schema(
 					},
 				},
 			},
+			"custom": resource_schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Configuration for adapters that don't have a dedicated block yet, e.g. ClickHouse or Salesforce Data Cloud. The config is sent as is to dbt Cloud, so the keys need to match the ones expected by the adapter.",
+				Attributes: map[string]resource_schema.Attribute{
+					"adapter_version": resource_schema.StringAttribute{
+						Required:    true,
+						Description: "The adapter version of the connection, e.g. `clickhouse_v0`. Changing it recreates the connection.",
+					},
+					"config": resource_schema.StringAttribute{
+						Optional:    true,
+						Description: "The connection config, as a JSON object (e.g. using `jsonencode()`). Only the keys set here are checked for drift, the other ones use the dbt Cloud defaults.",
+					},
+					"sensitive_config": resource_schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The secret fields of the connection config, as a JSON object. Those are not returned by dbt Cloud, so changes made outside of Terraform are not detected.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -1105,6 +1127,25 @@ func (r *globalConnectionDataSource) Schema(
 					},
 				},
 			},
+			"custom": datasource_schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Configuration of the adapters that don't have a dedicated block.",
+				Attributes: map[string]datasource_schema.Attribute{
+					"adapter_version": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The adapter version of the connection",
+					},
+					"config": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The connection config returned by dbt Cloud, as a JSON object",
+					},
+					"sensitive_config": datasource_schema.StringAttribute{
+						Computed:    true,
+						Sensitive:   true,
+						Description: "Always null, the secret fields are not returned by dbt Cloud",
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,161 @@
+package job
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+)
+
+// newJobCopy returns the definition of a copy of the source job in the given project and environment.
+// A source job deferring to itself is copied as a job deferring to itself, which can only be set once the copy is created,
+// so the deferral is removed from the definition and selfDeferring is returned as true.
+// The job completion trigger is copied as is, still referencing the upstream job in its own project.
+func newJobCopy(source dbt_cloud.Job, projectID int, environmentID int) (jobCopy dbt_cloud.Job, selfDeferring bool) {
+	jobCopy = source
+	jobCopy.ID = nil
+	jobCopy.ProjectId = projectID
+	jobCopy.EnvironmentId = environmentID
+	jobCopy.State = dbt_cloud.STATE_ACTIVE
+	jobCopy.ExecuteSteps = append([]string{}, source.ExecuteSteps...)
+
+	if source.JobCompletionTrigger != nil {
+		trigger := *source.JobCompletionTrigger
+		trigger.Condition.Statuses = append([]int{}, source.JobCompletionTrigger.Condition.Statuses...)
+		jobCopy.JobCompletionTrigger = &trigger
+	}
+
+	if source.ID != nil && source.DeferringJobId != nil && *source.DeferringJobId == *source.ID {
+		selfDeferring = true
+		jobCopy.DeferringJobId = nil
+	}
+
+	return jobCopy, selfDeferring
+}
+
+// applyJobCopyOverrides sets on the job all the fields that are overridden in the config of the copy.
+// Fields that are null in the config keep the value copied from the source job.
+func applyJobCopyOverrides(job *dbt_cloud.Job, model JobCopyResourceModel) {
+	if !model.Name.IsNull() {
+		job.Name = model.Name.ValueString()
+	}
+	if !model.Description.IsNull() {
+		job.Description = model.Description.ValueString()
+	}
+	if model.ExecuteSteps != nil {
+		job.ExecuteSteps = helper.TypesStringSliceToStringSlice(model.ExecuteSteps)
+	}
+	if !model.DbtVersion.IsNull() {
+		dbtVersion := model.DbtVersion.ValueString()
+		job.DbtVersion = &dbtVersion
+	}
+	if !model.NumThreads.IsNull() {
+		job.Settings.Threads = int(model.NumThreads.ValueInt64())
+	}
+	if !model.TargetName.IsNull() {
+		job.Settings.TargetName = model.TargetName.ValueString()
+	}
+	if !model.TimeoutSeconds.IsNull() {
+		job.Execution.TimeoutSeconds = int(model.TimeoutSeconds.ValueInt64())
+	}
+	if !model.GenerateDocs.IsNull() {
+		job.GenerateDocs = model.GenerateDocs.ValueBool()
+	}
+	if !model.RunGenerateSources.IsNull() {
+		job.RunGenerateSources = model.RunGenerateSources.ValueBool()
+	}
+	if !model.ScheduleCron.IsNull() {
+		scheduleCron := model.ScheduleCron.ValueString()
+		job.Schedule.Cron = scheduleCron
+		job.Schedule.Date.Type = "custom_cron"
+		job.Schedule.Date.Cron = &scheduleCron
+		job.Schedule.Date.Days = nil
+	}
+	if model.Triggers != nil {
+		job.Triggers = dbt_cloud.JobTrigger{
+			GithubWebhook:      model.Triggers.GithubWebhook.ValueBool(),
+			GitProviderWebhook: model.Triggers.GitProviderWebhook.ValueBool(),
+			Schedule:           model.Triggers.Schedule.ValueBool(),
+			OnMerge:            model.Triggers.OnMerge.ValueBool(),
+		}
+		// the job type is derived from the triggers, the same way as when creating a job
+		job.JobType = ""
+		if job.Triggers.OnMerge {
+			job.JobType = "merge"
+		}
+		if job.Triggers.GithubWebhook || job.Triggers.GitProviderWebhook {
+			job.JobType = "ci"
+		}
+	}
+	if !model.DeferringEnvironmentID.IsNull() {
+		deferringEnvironmentID := int(model.DeferringEnvironmentID.ValueInt64())
+		job.DeferringEnvironmentId = &deferringEnvironmentID
+	}
+}
+
+// jobCopySettingsHash returns a hash of the settings of the job that are copied from the source, i.e. not overridden in the config.
+// It is the same for the source and the copy when the copy is in sync, as the IDs, the project and the environment are ignored.
+func jobCopySettingsHash(job dbt_cloud.Job, model JobCopyResourceModel) (string, error) {
+	settings := job
+	settings.ID = nil
+	settings.AccountId = 0
+	settings.ProjectId = 0
+	settings.EnvironmentId = 0
+	settings.State = 0
+
+	if job.ID != nil && job.DeferringJobId != nil && *job.DeferringJobId == *job.ID {
+		selfDeferring := -1
+		settings.DeferringJobId = &selfDeferring
+	}
+
+	if !model.Name.IsNull() {
+		settings.Name = ""
+	}
+	if !model.Description.IsNull() {
+		settings.Description = ""
+	}
+	if model.ExecuteSteps != nil {
+		settings.ExecuteSteps = nil
+	}
+	if !model.DbtVersion.IsNull() {
+		settings.DbtVersion = nil
+	}
+	if !model.NumThreads.IsNull() {
+		settings.Settings.Threads = 0
+	}
+	if !model.TargetName.IsNull() {
+		settings.Settings.TargetName = ""
+	}
+	if !model.TimeoutSeconds.IsNull() {
+		settings.Execution.TimeoutSeconds = 0
+	}
+	if !model.GenerateDocs.IsNull() {
+		settings.GenerateDocs = false
+	}
+	if !model.RunGenerateSources.IsNull() {
+		settings.RunGenerateSources = false
+	}
+	if !model.ScheduleCron.IsNull() {
+		settings.Schedule.Cron = ""
+		settings.Schedule.Date.Type = ""
+		settings.Schedule.Date.Cron = nil
+		settings.Schedule.Date.Days = nil
+	}
+	if model.Triggers != nil {
+		settings.Triggers = dbt_cloud.JobTrigger{}
+		settings.JobType = ""
+	}
+	if !model.DeferringEnvironmentID.IsNull() {
+		settings.DeferringEnvironmentId = nil
+	}
+
+	settingsJSON, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(settingsJSON)
+	return hex.EncodeToString(hash[:]), nil
+}
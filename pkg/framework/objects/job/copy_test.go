@@ -0,0 +1,131 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCopyModel() JobCopyResourceModel {
+	return JobCopyResourceModel{
+		Name:                   types.StringValue("copy"),
+		Description:            types.StringNull(),
+		DbtVersion:             types.StringNull(),
+		NumThreads:             types.Int64Value(8),
+		TargetName:             types.StringNull(),
+		TimeoutSeconds:         types.Int64Null(),
+		GenerateDocs:           types.BoolNull(),
+		RunGenerateSources:     types.BoolNull(),
+		ScheduleCron:           types.StringNull(),
+		DeferringEnvironmentID: types.Int64Null(),
+	}
+}
+
+func TestNewJobCopy(t *testing.T) {
+	sourceID := 10
+	source := dbt_cloud.Job{
+		ID:             &sourceID,
+		ProjectId:      1,
+		EnvironmentId:  2,
+		Name:           "source",
+		ExecuteSteps:   []string{"dbt build"},
+		DeferringJobId: &sourceID,
+		Settings:       dbt_cloud.JobSettings{Threads: 4, TargetName: "prod"},
+		State:          dbt_cloud.STATE_ACTIVE,
+	}
+
+	jobCopy, selfDeferring := newJobCopy(source, 1, 3)
+	applyJobCopyOverrides(&jobCopy, testCopyModel())
+
+	assert.True(t, selfDeferring)
+	assert.Nil(t, jobCopy.ID)
+	assert.Nil(t, jobCopy.DeferringJobId)
+	assert.Equal(t, 3, jobCopy.EnvironmentId)
+	assert.Equal(t, "copy", jobCopy.Name)
+	assert.Equal(t, 8, jobCopy.Settings.Threads)
+	assert.Equal(t, "prod", jobCopy.Settings.TargetName)
+	assert.Equal(t, []string{"dbt build"}, jobCopy.ExecuteSteps)
+
+	// the source must not be modified
+	assert.Equal(t, "source", source.Name)
+	assert.Equal(t, &sourceID, source.DeferringJobId)
+}
+
+func TestJobCopySettingsHash(t *testing.T) {
+	sourceID := 10
+	source := dbt_cloud.Job{
+		ID:             &sourceID,
+		ProjectId:      1,
+		EnvironmentId:  2,
+		Name:           "source",
+		ExecuteSteps:   []string{"dbt build"},
+		DeferringJobId: &sourceID,
+		Settings:       dbt_cloud.JobSettings{Threads: 4, TargetName: "prod"},
+	}
+	model := testCopyModel()
+
+	jobCopy, _ := newJobCopy(source, 1, 3)
+	applyJobCopyOverrides(&jobCopy, model)
+	copyID := 20
+	jobCopy.ID = &copyID
+	jobCopy.DeferringJobId = &copyID
+
+	sourceHash, err := jobCopySettingsHash(source, model)
+	assert.NoError(t, err)
+	copyHash, err := jobCopySettingsHash(jobCopy, model)
+	assert.NoError(t, err)
+	assert.Equal(t, sourceHash, copyHash)
+
+	// changing an overridden field doesn't change the hash
+	model.NumThreads = types.Int64Value(16)
+	applyJobCopyOverrides(&jobCopy, model)
+	copyHash, err = jobCopySettingsHash(jobCopy, model)
+	assert.NoError(t, err)
+	assert.Equal(t, sourceHash, copyHash)
+
+	// changing a copied field does
+	source.ExecuteSteps = []string{"dbt test"}
+	newSourceHash, err := jobCopySettingsHash(source, model)
+	assert.NoError(t, err)
+	assert.NotEqual(t, sourceHash, newSourceHash)
+}
+
+func TestNewJobCopyInAnotherProject(t *testing.T) {
+	sourceID := 10
+	source := dbt_cloud.Job{
+		ID:            &sourceID,
+		ProjectId:     1,
+		EnvironmentId: 2,
+		Name:          "source",
+		ExecuteSteps:  []string{"dbt build"},
+		JobCompletionTrigger: &dbt_cloud.JobCompletionTrigger{
+			Condition: dbt_cloud.JobCompletionTriggerCondition{JobID: 5, ProjectID: 1, Statuses: []int{10}},
+		},
+	}
+	model := testCopyModel()
+	model.ScheduleCron = types.StringValue("0 5 * * *")
+
+	jobCopy, _ := newJobCopy(source, 7, 3)
+	applyJobCopyOverrides(&jobCopy, model)
+
+	assert.Equal(t, 7, jobCopy.ProjectId)
+	// the upstream job of the trigger stays the one of the source project
+	assert.Equal(t, 1, jobCopy.JobCompletionTrigger.Condition.ProjectID)
+	assert.Equal(t, 5, jobCopy.JobCompletionTrigger.Condition.JobID)
+	assert.Equal(t, "0 5 * * *", jobCopy.Schedule.Cron)
+	assert.Equal(t, "0 5 * * *", *jobCopy.Schedule.Date.Cron)
+	assert.Equal(t, "custom_cron", jobCopy.Schedule.Date.Type)
+
+	// the trigger of the source must not be modified
+	jobCopy.JobCompletionTrigger.Condition.Statuses[0] = 20
+	assert.Equal(t, []int{10}, source.JobCompletionTrigger.Condition.Statuses)
+	jobCopy.JobCompletionTrigger.Condition.Statuses[0] = 10
+
+	sourceHash, err := jobCopySettingsHash(source, model)
+	assert.NoError(t, err)
+	copyHash, err := jobCopySettingsHash(jobCopy, model)
+	assert.NoError(t, err)
+	assert.Equal(t, sourceHash, copyHash)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -57,26 +58,93 @@ func (j *jobDataSource) ValidateConfig(
 		return
 	}
 
-	if data.JobId.IsNull() {
+	lookupByName := !data.ProjectID.IsNull() || !data.EnvironmentID.IsNull() || !data.Name.IsNull()
+
+	if !data.JobId.IsNull() && lookupByName {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("job_id"),
+			"Invalid Attribute Configuration",
+			"job_id can't be configured together with project_id, environment_id and name.",
+		)
+		return
+	}
+
+	if data.JobId.IsNull() &&
+		(data.ProjectID.IsNull() || data.EnvironmentID.IsNull() || data.Name.IsNull()) {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("job_id"),
 			"Missing Required Attribute",
-			"job_id must be configured.",
+			"Either job_id or all of project_id, environment_id and name must be configured.",
+		)
+	}
+}
+
+// findJobByName returns the job with the given name in the environment, and an error if there is none or more than one
+func (j *jobDataSource) findJobByName(projectID int, environmentID int, name string) (*dbt_cloud.Job, error) {
+	environmentJobs, err := j.client.GetAllJobs(0, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingJobs := lo.Filter(environmentJobs, func(job dbt_cloud.JobWithEnvironment, _ int) bool {
+		return job.ProjectId == projectID && job.Name == name
+	})
+
+	switch len(matchingJobs) {
+	case 0:
+		return nil, fmt.Errorf(
+			"no job named %q was found in project %d and environment %d",
+			name,
+			projectID,
+			environmentID,
+		)
+	case 1:
+		return &matchingJobs[0].Job, nil
+	default:
+		jobIDs := lo.Map(matchingJobs, func(job dbt_cloud.JobWithEnvironment, _ int) string {
+			return strconv.Itoa(*job.ID)
+		})
+		return nil, fmt.Errorf(
+			"%d jobs named %q were found in project %d and environment %d (job IDs: %s), use job_id to select one of them",
+			len(matchingJobs),
+			name,
+			projectID,
+			environmentID,
+			strings.Join(jobIDs, ", "),
 		)
 	}
 }
 
+func jobRunToModel(run *dbt_cloud.Run) *JobRun {
+	if run == nil {
+		return nil
+	}
+	return &JobRun{
+		ID:         types.Int64Value(run.ID),
+		Status:     types.StringValue(dbt_cloud.RunStatusesHumanized[run.Status]),
+		FinishedAt: types.StringValue(run.FinishedAt),
+		GitSHA:     types.StringValue(run.GitSHA),
+	}
+}
+
 func (j *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	
 	var state SingleJobDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	
-	jobIdValue := state.JobId.ValueInt64()
-	
-	jobId := strconv.FormatInt(jobIdValue, 10)
-
-	job, err := j.client.GetJob(jobId)
+	var job *dbt_cloud.Job
+	var err error
+	if state.JobId.IsNull() {
+		job, err = j.findJobByName(
+			int(state.ProjectID.ValueInt64()),
+			int(state.EnvironmentID.ValueInt64()),
+			state.Name.ValueString(),
+		)
+	} else {
+		jobId := strconv.FormatInt(state.JobId.ValueInt64(), 10)
+		job, err = j.client.GetJob(jobId)
+	}
 
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error getting job: %s", err.Error()))
@@ -84,6 +152,22 @@ func (j *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	// the runs are only retrieved on demand as they require two more API calls
+	if state.IncludeLatestRuns.ValueBool() {
+		latestRun, err := j.client.GetLatestJobRun(*job.ID, 0)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting the latest run of the job", err.Error())
+			return
+		}
+		latestSuccessfulRun, err := j.client.GetLatestJobRun(*job.ID, dbt_cloud.RUN_STATUS_SUCCESS)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting the latest successful run of the job", err.Error())
+			return
+		}
+		state.LatestRun = jobRunToModel(latestRun)
+		state.LatestSuccessfulRun = jobRunToModel(latestSuccessfulRun)
+	}
+
 	state.Execution = &JobExecution{
 		TimeoutSeconds: types.Int64Value(int64(job.Execution.TimeoutSeconds)),
 	}
@@ -91,6 +175,7 @@ func (j *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	state.GenerateDocs = types.BoolValue(job.GenerateDocs)
 	state.RunGenerateSources = types.BoolValue(job.RunGenerateSources)
 	state.ID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(job.ID))
+	state.JobId = types.Int64PointerValue(helper.IntPointerToInt64Pointer(job.ID))
 	state.ProjectID = types.Int64Value(int64(job.ProjectId))
 	state.EnvironmentID = types.Int64Value(int64(job.EnvironmentId))
 	state.Name = types.StringValue(job.Name)
@@ -116,6 +201,10 @@ func (j *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	state.JobType = types.StringValue(job.JobType)
 	state.TriggersOnDraftPr = types.BoolValue(job.TriggersOnDraftPR)
 	state.RunCompareChanges = types.BoolValue(job.RunCompareChanges)
+	state.ForceNodeSelection = types.BoolPointerValue(job.ForceNodeSelection)
+	if job.CostOptimizationFeatures != nil {
+		state.CostOptimizationFeatures = helper.SliceStringToSliceTypesString(*job.CostOptimizationFeatures)
+	}
 
 	if job.JobCompletionTrigger != nil {
 		state.JobCompletionTriggerCondition = []*JobCompletionTriggerCondition{
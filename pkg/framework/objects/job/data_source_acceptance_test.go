@@ -29,6 +29,16 @@ func TestDbtCloudJobDataSource(t *testing.T) {
 			"job_completion_trigger_condition.#",
 			"0",
 		),
+		resource.TestCheckNoResourceAttr("data.dbtcloud_job.test", "latest_run.id"),
+		resource.TestCheckNoResourceAttr("data.dbtcloud_job.test", "latest_successful_run.id"),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_job.test_by_name",
+			"job_id",
+			"dbtcloud_job.test_job",
+			"id",
+		),
+		resource.TestCheckResourceAttr("data.dbtcloud_job.test_by_name", "execution.timeout_seconds", "180"),
+		resource.TestCheckNoResourceAttr("data.dbtcloud_job.test_by_name", "latest_run.id"),
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,5 +83,12 @@ func jobResourceConfig(jobName string) string {
     data "dbtcloud_job" "test" {
         job_id = dbtcloud_job.test_job.id
     }
+
+    data "dbtcloud_job" "test_by_name" {
+        project_id = dbtcloud_project.test_project.id
+        environment_id = dbtcloud_environment.test_environment.environment_id
+        name = dbtcloud_job.test_job.name
+        include_latest_runs = true
+    }
     `, acctest_config.DBT_CLOUD_VERSION, jobName)
 }
@@ -89,6 +89,11 @@ func (d *jobsDataSource) Read(
 			}
 		}
 
+		var costOptimizationFeatures []types.String
+		if job.CostOptimizationFeatures != nil {
+			costOptimizationFeatures = helper.SliceStringToSliceTypesString(*job.CostOptimizationFeatures)
+		}
+
 		currentJob := JobDataSourceModel{
 			Execution: &JobExecution{
 				TimeoutSeconds: types.Int64Value(int64(job.Execution.TimeoutSeconds)),
@@ -125,9 +130,11 @@ func (d *jobsDataSource) Read(
 			Schedule: &JobSchedule{
 				Cron: types.StringValue(job.Schedule.Cron),
 			},
-			JobType:           types.StringValue(job.JobType),
-			TriggersOnDraftPr: types.BoolValue(job.TriggersOnDraftPR),
-			RunCompareChanges: types.BoolValue(job.RunCompareChanges),
+			JobType:                  types.StringValue(job.JobType),
+			TriggersOnDraftPr:        types.BoolValue(job.TriggersOnDraftPR),
+			RunCompareChanges:        types.BoolValue(job.RunCompareChanges),
+			ForceNodeSelection:       types.BoolPointerValue(job.ForceNodeSelection),
+			CostOptimizationFeatures: costOptimizationFeatures,
 			Environment: &JobEnvironment{
 				ProjectID:      types.Int64Value(int64(job.Environment.Project_Id)),
 				ID:             types.Int64Value(int64(*job.Environment.ID)),
@@ -0,0 +1,132 @@
+package job
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &jobGraphDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobGraphDataSource{}
+)
+
+func JobGraphDataSource() datasource.DataSource {
+	return &jobGraphDataSource{}
+}
+
+type jobGraphDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *jobGraphDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_graph"
+}
+
+func (d *jobGraphDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config JobGraphDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDs := lo.Map(config.ProjectIDs, func(projectID types.Int64, _ int) int {
+		return int(projectID.ValueInt64())
+	})
+
+	if config.ProjectIDs == nil {
+		allProjects, err := d.client.GetAllProjects("")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when retrieving projects",
+				err.Error(),
+			)
+			return
+		}
+		projectIDs = lo.Map(allProjects, func(project dbt_cloud.ProjectConnectionRepository, _ int) int {
+			return int(project.ID)
+		})
+	}
+
+	allJobs := []dbt_cloud.JobWithEnvironment{}
+	for _, projectID := range projectIDs {
+		projectJobs, err := d.client.GetAllJobs(projectID, 0)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when retrieving jobs",
+				err.Error(),
+			)
+			return
+		}
+		allJobs = append(allJobs, projectJobs...)
+	}
+
+	graph := buildJobGraph(allJobs)
+
+	state := config
+
+	state.Nodes = lo.Map(graph.Jobs, func(job dbt_cloud.JobWithEnvironment, _ int) JobGraphNode {
+		return JobGraphNode{
+			JobID:         types.Int64Value(int64(*job.ID)),
+			Name:          types.StringValue(job.Name),
+			ProjectID:     types.Int64Value(int64(job.ProjectId)),
+			EnvironmentID: types.Int64Value(int64(job.EnvironmentId)),
+			JobType:       types.StringValue(job.JobType),
+			DeferringJobID: types.Int64PointerValue(
+				helper.IntPointerToInt64Pointer(job.DeferringJobId),
+			),
+			DeferringEnvironmentID: types.Int64PointerValue(
+				helper.IntPointerToInt64Pointer(job.DeferringEnvironmentId),
+			),
+		}
+	})
+
+	state.Edges = lo.Map(graph.Edges, func(edge jobGraphEdge, _ int) JobGraphEdge {
+		return JobGraphEdge{
+			Type: types.StringValue(edge.Type),
+			UpstreamJobID: types.Int64PointerValue(
+				helper.IntPointerToInt64Pointer(edge.UpstreamJobID),
+			),
+			UpstreamEnvironmentID: types.Int64PointerValue(
+				helper.IntPointerToInt64Pointer(edge.UpstreamEnvironmentID),
+			),
+			DownstreamJobID: types.Int64Value(int64(edge.DownstreamJobID)),
+		}
+	})
+
+	state.Roots = helper.SliceIntToSliceTypesInt64(graph.Roots)
+	state.Leaves = helper.SliceIntToSliceTypesInt64(graph.Leaves)
+	state.Cycles = lo.Map(graph.Cycles, func(cycle []int, _ int) JobGraphCycle {
+		return JobGraphCycle{
+			JobIDs: helper.SliceIntToSliceTypesInt64(cycle),
+		}
+	})
+	state.HasCycles = types.BoolValue(len(graph.Cycles) > 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *jobGraphDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
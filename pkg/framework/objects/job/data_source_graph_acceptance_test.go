@@ -0,0 +1,124 @@
+package job_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDbtCloudJobGraphDataSource(t *testing.T) {
+
+	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomJobName2 := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	config := jobGraphResourceConfig(randomJobName, randomJobName2)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "nodes.#", "2"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.#", "1"),
+		resource.TestCheckResourceAttr(
+			"data.dbtcloud_job_graph.test",
+			"edges.0.type",
+			"job_completion_trigger",
+		),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_job_graph.test",
+			"edges.0.upstream_job_id",
+			"dbtcloud_job.test_job_upstream",
+			"id",
+		),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_job_graph.test",
+			"edges.0.downstream_job_id",
+			"dbtcloud_job.test_job_downstream",
+			"id",
+		),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "roots.#", "1"),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_job_graph.test",
+			"roots.0",
+			"dbtcloud_job.test_job_upstream",
+			"id",
+		),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "leaves.#", "1"),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_job_graph.test",
+			"leaves.0",
+			"dbtcloud_job.test_job_downstream",
+			"id",
+		),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "cycles.#", "0"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "has_cycles", "false"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func jobGraphResourceConfig(jobName string, jobName2 string) string {
+	return fmt.Sprintf(`
+    resource "dbtcloud_project" "test_project" {
+        name = "job_graph_test_project"
+    }
+
+    resource "dbtcloud_environment" "test_environment" {
+        project_id = dbtcloud_project.test_project.id
+        name = "job_graph_test_env"
+        dbt_version = "%s"
+        type = "deployment"
+        deployment_type = "production"
+    }
+
+    resource "dbtcloud_job" "test_job_upstream" {
+        name = "%s"
+        project_id = dbtcloud_project.test_project.id
+        environment_id = dbtcloud_environment.test_environment.environment_id
+        execute_steps = [
+            "dbt run"
+        ]
+        triggers = {
+          "github_webhook" : false,
+          "schedule" : false,
+          "git_provider_webhook": false
+        }
+    }
+
+    resource "dbtcloud_job" "test_job_downstream" {
+        name = "%s"
+        project_id = dbtcloud_project.test_project.id
+        environment_id = dbtcloud_environment.test_environment.environment_id
+        execute_steps = [
+            "dbt test"
+        ]
+        triggers = {
+          "github_webhook" : false,
+          "schedule" : false,
+          "git_provider_webhook": false
+        }
+        job_completion_trigger_condition {
+            job_id = dbtcloud_job.test_job_upstream.id
+            project_id = dbtcloud_project.test_project.id
+            statuses = ["success"]
+        }
+    }
+
+    data "dbtcloud_job_graph" "test" {
+        project_ids = [dbtcloud_project.test_project.id]
+        depends_on = [
+            dbtcloud_job.test_job_upstream,
+            dbtcloud_job.test_job_downstream,
+        ]
+    }
+    `, acctest_config.AcceptanceTestConfig.DbtCloudVersion, jobName, jobName2)
+}
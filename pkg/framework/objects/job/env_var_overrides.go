@@ -0,0 +1,286 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// envVarOverridesPrivateKey is the private state key storing the names of the overrides managed by `env_var_overrides`.
+// It is used to tell apart the overrides created by the job resource from the ones created outside of it,
+// e.g. with `dbtcloud_environment_variable_job_override`.
+const envVarOverridesPrivateKey = "env_var_overrides_managed"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// diffEnvVarOverrides compares the existing overrides of a job with the planned ones
+// and returns the overrides to create, the ones to update with their new value and the ones to delete.
+func diffEnvVarOverrides(
+	existing []dbt_cloud.EnvironmentVariableJobOverride,
+	planned map[string]string,
+) (
+	toCreate []dbt_cloud.EnvironmentVariableJobOverride,
+	toUpdate []dbt_cloud.EnvironmentVariableJobOverride,
+	toDelete []dbt_cloud.EnvironmentVariableJobOverride,
+) {
+	existingByName := map[string]dbt_cloud.EnvironmentVariableJobOverride{}
+	for _, override := range existing {
+		existingByName[override.Name] = override
+		if _, ok := planned[override.Name]; !ok {
+			toDelete = append(toDelete, override)
+		}
+	}
+
+	names := make([]string, 0, len(planned))
+	for name := range planned {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := planned[name]
+		override, ok := existingByName[name]
+		if !ok {
+			toCreate = append(toCreate, dbt_cloud.EnvironmentVariableJobOverride{
+				Name:     name,
+				RawValue: value,
+			})
+			continue
+		}
+		if override.RawValue != value {
+			override.RawValue = value
+			toUpdate = append(toUpdate, override)
+		}
+	}
+
+	return toCreate, toUpdate, toDelete
+}
+
+// reconcileEnvVarOverrides creates, updates and deletes the overrides of the job so that they match `env_var_overrides`
+func (j *jobResource) reconcileEnvVarOverrides(
+	ctx context.Context,
+	projectID int,
+	jobID int,
+	envVarOverrides types.Map,
+	private privateStateSetter,
+) (diags diag.Diagnostics) {
+	planned := map[string]string{}
+	diags.Append(envVarOverrides.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := j.client.GetEnvironmentVariableJobOverrides(projectID, jobID)
+	if err != nil {
+		diags.AddError("Error retrieving the environment variable overrides of the job", err.Error())
+		return diags
+	}
+
+	toCreate, toUpdate, toDelete := diffEnvVarOverrides(existing, planned)
+
+	// the managed overrides are recorded even when a step fails, so that the ones already created
+	// are still known as created by `env_var_overrides` on the next plan
+	managed := map[string]bool{}
+	for _, override := range existing {
+		if _, ok := planned[override.Name]; ok {
+			managed[override.Name] = true
+		}
+	}
+	defer func() {
+		names := make([]string, 0, len(managed))
+		for name := range managed {
+			names = append(names, name)
+		}
+		diags.Append(setManagedEnvVarOverrides(ctx, private, names)...)
+	}()
+
+	for _, override := range toDelete {
+		_, err := j.client.DeleteEnvironmentVariableJobOverride(projectID, *override.ID)
+		if err != nil {
+			diags.AddError(
+				"Error deleting an environment variable override of the job",
+				fmt.Sprintf("Could not delete the override of %s: %s", override.Name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	for _, override := range toUpdate {
+		_, err := j.client.UpdateEnvironmentVariableJobOverride(projectID, *override.ID, override)
+		if err != nil {
+			diags.AddError(
+				"Error updating an environment variable override of the job",
+				fmt.Sprintf("Could not update the override of %s: %s", override.Name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	for _, override := range toCreate {
+		_, err := j.client.CreateEnvironmentVariableJobOverride(
+			projectID,
+			override.Name,
+			override.RawValue,
+			jobID,
+		)
+		if err != nil {
+			diags.AddError(
+				"Error creating an environment variable override of the job",
+				fmt.Sprintf("Could not create the override of %s: %s", override.Name, err.Error()),
+			)
+			return diags
+		}
+		managed[override.Name] = true
+	}
+
+	return diags
+}
+
+// readEnvVarOverrides returns all the overrides of the job, as a map of variable names to values
+func (j *jobResource) readEnvVarOverrides(
+	ctx context.Context,
+	projectID int,
+	jobID int,
+) (types.Map, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := j.client.GetEnvironmentVariableJobOverrides(projectID, jobID)
+	if err != nil {
+		diags.AddError("Error retrieving the environment variable overrides of the job", err.Error())
+		return types.MapNull(types.StringType), nil, diags
+	}
+
+	values := map[string]string{}
+	names := []string{}
+	for _, override := range existing {
+		values[override.Name] = override.RawValue
+		names = append(names, override.Name)
+	}
+
+	envVarOverrides, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(mapDiags...)
+	return envVarOverrides, names, diags
+}
+
+func getManagedEnvVarOverrides(
+	ctx context.Context,
+	private privateStateGetter,
+) (names []string, found bool, diags diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, envVarOverridesPrivateKey)
+	if diags.HasError() || value == nil {
+		return nil, false, diags
+	}
+
+	if err := json.Unmarshal(value, &names); err != nil {
+		diags.AddError("Error reading the private state of the job", err.Error())
+		return nil, false, diags
+	}
+	return names, true, diags
+}
+
+func setManagedEnvVarOverrides(
+	ctx context.Context,
+	private privateStateSetter,
+	names []string,
+) diag.Diagnostics {
+	sort.Strings(names)
+	value, err := json.Marshal(names)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error writing the private state of the job", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, envVarOverridesPrivateKey, value)
+}
+
+// validateEnvVarOverridesNotSecret rejects secret environment variables in `env_var_overrides`:
+// their values are masked by the API so they can't be compared with the config, and they would be stored in plain text in the state.
+// Those overrides should use the write-only `secret_raw_value` of `dbtcloud_environment_variable_job_override` instead.
+func validateEnvVarOverridesNotSecret(envVarOverrides types.Map, diags *diag.Diagnostics) {
+	if envVarOverrides.IsNull() || envVarOverrides.IsUnknown() {
+		return
+	}
+
+	secretNames := []string{}
+	for name := range envVarOverrides.Elements() {
+		if helper.IsSecretEnvironmentVariable(name) {
+			secretNames = append(secretNames, name)
+		}
+	}
+	if len(secretNames) == 0 {
+		return
+	}
+	sort.Strings(secretNames)
+
+	diags.AddAttributeError(
+		path.Root("env_var_overrides"),
+		"Secret environment variables in env_var_overrides",
+		fmt.Sprintf(
+			"%s can't be overridden in `env_var_overrides` as the values of secret environment variables are masked by dbt Cloud. "+
+				"Use `dbtcloud_environment_variable_job_override` resources with `secret_raw_value` instead.",
+			strings.Join(secretNames, ", "),
+		),
+	)
+}
+
+// warnOnEnvVarOverridesConflict adds a warning when `env_var_overrides` is going to delete overrides that were not created by it.
+// Those overrides are most likely managed by `dbtcloud_environment_variable_job_override` resources,
+// and both resources would keep deleting/recreating them.
+func (j *jobResource) warnOnEnvVarOverridesConflict(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	plan JobResourceModel,
+	state JobResourceModel,
+	resp *resource.ModifyPlanResponse,
+) {
+	if plan.EnvVarOverrides.IsNull() || plan.EnvVarOverrides.IsUnknown() || state.EnvVarOverrides.IsNull() {
+		return
+	}
+
+	managedNames, found, diags := getManagedEnvVarOverrides(ctx, req.Private)
+	if diags.HasError() || !found {
+		return
+	}
+	managed := map[string]bool{}
+	for _, name := range managedNames {
+		managed[name] = true
+	}
+
+	planned := plan.EnvVarOverrides.Elements()
+	conflicts := []string{}
+	for name := range state.EnvVarOverrides.Elements() {
+		if _, ok := planned[name]; !ok && !managed[name] {
+			conflicts = append(conflicts, name)
+		}
+	}
+	if len(conflicts) == 0 {
+		return
+	}
+	sort.Strings(conflicts)
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("env_var_overrides"),
+		"Environment variable overrides managed outside of the job",
+		fmt.Sprintf(
+			"The job has overrides for %s that were not created through `env_var_overrides` and are going to be deleted. "+
+				"If they are managed with `dbtcloud_environment_variable_job_override` resources, "+
+				"add them to `env_var_overrides` and remove those resources as the overrides of a job should be managed in only one place.",
+			strings.Join(conflicts, ", "),
+		),
+	)
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffEnvVarOverrides(t *testing.T) {
+	id1, id2, id3 := 1, 2, 3
+	existing := []dbt_cloud.EnvironmentVariableJobOverride{
+		{ID: &id1, Name: "DBT_UNCHANGED", RawValue: "a"},
+		{ID: &id2, Name: "DBT_UPDATED", RawValue: "b"},
+		{ID: &id3, Name: "DBT_DELETED", RawValue: "c"},
+	}
+	planned := map[string]string{
+		"DBT_UNCHANGED": "a",
+		"DBT_UPDATED":   "b2",
+		"DBT_NEW_2":     "e",
+		"DBT_NEW_1":     "d",
+	}
+
+	toCreate, toUpdate, toDelete := diffEnvVarOverrides(existing, planned)
+
+	assert.Equal(t, []dbt_cloud.EnvironmentVariableJobOverride{
+		{Name: "DBT_NEW_1", RawValue: "d"},
+		{Name: "DBT_NEW_2", RawValue: "e"},
+	}, toCreate)
+	assert.Equal(t, []dbt_cloud.EnvironmentVariableJobOverride{
+		{ID: &id2, Name: "DBT_UPDATED", RawValue: "b2"},
+	}, toUpdate)
+	assert.Equal(t, []dbt_cloud.EnvironmentVariableJobOverride{
+		{ID: &id3, Name: "DBT_DELETED", RawValue: "c"},
+	}, toDelete)
+
+	// an empty map deletes all the overrides
+	toCreate, toUpdate, toDelete = diffEnvVarOverrides(existing, map[string]string{})
+	assert.Empty(t, toCreate)
+	assert.Empty(t, toUpdate)
+	assert.Len(t, toDelete, 3)
+}
+
+func TestValidateEnvVarOverridesNotSecret(t *testing.T) {
+	var diags diag.Diagnostics
+	validateEnvVarOverridesNotSecret(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"DBT_VAR": types.StringValue("a"),
+	}), &diags)
+	assert.False(t, diags.HasError())
+
+	validateEnvVarOverridesNotSecret(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"DBT_VAR":              types.StringValue("a"),
+		"DBT_ENV_SECRET_TOKEN": types.StringValue("b"),
+	}), &diags)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "DBT_ENV_SECRET_TOKEN")
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// forceNodeSelectionPrivateKey is the private state key recording whether `force_node_selection` was set in the config
+// during the last apply. As the attribute is computed, it is needed to tell apart a value removed from the config,
+// which should be reset, from a value that was never configured and is just read from dbt Cloud.
+const forceNodeSelectionPrivateKey = "force_node_selection_configured"
+
+// defaultForceNodeSelection is the value `force_node_selection` is reset to when it is removed from the config,
+// i.e. running all the selected nodes
+const defaultForceNodeSelection = true
+
+func setForceNodeSelectionConfigured(
+	ctx context.Context,
+	config tfsdk.Config,
+	private privateStateSetter,
+) diag.Diagnostics {
+	var forceNodeSelection types.Bool
+	diags := config.GetAttribute(ctx, path.Root("force_node_selection"), &forceNodeSelection)
+	if diags.HasError() {
+		return diags
+	}
+	configured := !forceNodeSelection.IsNull()
+	diags.Append(private.SetKey(ctx, forceNodeSelectionPrivateKey, []byte(strconv.FormatBool(configured)))...)
+	return diags
+}
+
+// resetForceNodeSelection plans `force_node_selection` back to its default value when it was removed from the config,
+// instead of keeping the value of the state
+func resetForceNodeSelection(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var forceNodeSelection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("force_node_selection"), &forceNodeSelection)...)
+	if resp.Diagnostics.HasError() || !forceNodeSelection.IsNull() {
+		return
+	}
+
+	configured, diags := req.Private.GetKey(ctx, forceNodeSelectionPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || string(configured) != "true" {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("force_node_selection"), types.BoolValue(defaultForceNodeSelection))...,
+	)
+}
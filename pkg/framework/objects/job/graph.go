@@ -0,0 +1,181 @@
+package job
+
+import (
+	"sort"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+const (
+	JobGraphEdgeTypeCompletionTrigger    = "job_completion_trigger"
+	JobGraphEdgeTypeDeferringJob         = "deferring_job"
+	JobGraphEdgeTypeDeferringEnvironment = "deferring_environment"
+)
+
+type jobGraphEdge struct {
+	Type                  string
+	UpstreamJobID         *int
+	UpstreamEnvironmentID *int
+	DownstreamJobID       int
+}
+
+type jobGraph struct {
+	Jobs   []dbt_cloud.JobWithEnvironment
+	Edges  []jobGraphEdge
+	Roots  []int
+	Leaves []int
+	Cycles [][]int
+}
+
+// buildJobGraph links the jobs together based on their job completion triggers and deferral settings.
+// Roots and leaves only consider the links between jobs (triggers and job deferral) and ignore self-deferral.
+// Cycles are only searched in the job completion triggers as this is the only relationship that actually starts runs.
+func buildJobGraph(jobs []dbt_cloud.JobWithEnvironment) jobGraph {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return *jobs[i].ID < *jobs[j].ID
+	})
+
+	graph := jobGraph{
+		Jobs:   jobs,
+		Edges:  []jobGraphEdge{},
+		Roots:  []int{},
+		Leaves: []int{},
+		Cycles: [][]int{},
+	}
+
+	knownJobs := map[int]bool{}
+	for _, job := range jobs {
+		knownJobs[*job.ID] = true
+	}
+
+	hasUpstream := map[int]bool{}
+	hasDownstream := map[int]bool{}
+	triggeredBy := map[int]int{}
+
+	for _, job := range jobs {
+		jobID := *job.ID
+
+		if job.JobCompletionTrigger != nil {
+			upstreamJobID := job.JobCompletionTrigger.Condition.JobID
+			graph.Edges = append(graph.Edges, jobGraphEdge{
+				Type:            JobGraphEdgeTypeCompletionTrigger,
+				UpstreamJobID:   &upstreamJobID,
+				DownstreamJobID: jobID,
+			})
+			triggeredBy[jobID] = upstreamJobID
+			if knownJobs[upstreamJobID] {
+				hasUpstream[jobID] = true
+				hasDownstream[upstreamJobID] = true
+			}
+		}
+
+		if job.DeferringJobId != nil && *job.DeferringJobId != jobID {
+			upstreamJobID := *job.DeferringJobId
+			graph.Edges = append(graph.Edges, jobGraphEdge{
+				Type:            JobGraphEdgeTypeDeferringJob,
+				UpstreamJobID:   &upstreamJobID,
+				DownstreamJobID: jobID,
+			})
+			if knownJobs[upstreamJobID] {
+				hasUpstream[jobID] = true
+				hasDownstream[upstreamJobID] = true
+			}
+		}
+
+		if job.DeferringEnvironmentId != nil {
+			upstreamEnvironmentID := *job.DeferringEnvironmentId
+			graph.Edges = append(graph.Edges, jobGraphEdge{
+				Type:                  JobGraphEdgeTypeDeferringEnvironment,
+				UpstreamEnvironmentID: &upstreamEnvironmentID,
+				DownstreamJobID:       jobID,
+			})
+		}
+	}
+
+	for _, job := range jobs {
+		if !hasUpstream[*job.ID] {
+			graph.Roots = append(graph.Roots, *job.ID)
+		}
+		if !hasDownstream[*job.ID] {
+			graph.Leaves = append(graph.Leaves, *job.ID)
+		}
+	}
+
+	graph.Cycles = findTriggerCycles(jobs, triggeredBy)
+
+	return graph
+}
+
+// findTriggerCycles returns the cycles found in the job completion triggers.
+// A job can only be triggered by one other job, so following the upstream job from every job is enough to find all the cycles.
+func findTriggerCycles(jobs []dbt_cloud.JobWithEnvironment, triggeredBy map[int]int) [][]int {
+	const (
+		notVisited = iota
+		inProgress
+		done
+	)
+
+	cycles := [][]int{}
+	status := map[int]int{}
+
+	for _, job := range jobs {
+		path := []int{}
+		current := *job.ID
+		hasUpstream := true
+
+		for hasUpstream && status[current] == notVisited {
+			status[current] = inProgress
+			path = append(path, current)
+			current, hasUpstream = triggeredBy[current]
+		}
+
+		if hasUpstream && status[current] == inProgress {
+			for i, jobID := range path {
+				if jobID == current {
+					cycles = append(cycles, path[i:])
+					break
+				}
+			}
+		}
+
+		for _, jobID := range path {
+			status[jobID] = done
+		}
+	}
+
+	return cycles
+}
+
+// findNewTriggerCycle checks whether making jobID triggered by upstreamJobID would create a cycle.
+// It follows the chain of job completion triggers from the upstream job and returns the job IDs of the cycle
+// (starting with jobID) if the chain comes back to jobID, nil otherwise.
+func findNewTriggerCycle(
+	jobID int,
+	upstreamJobID int,
+	getJob func(jobID int) (*dbt_cloud.Job, error),
+) ([]int, error) {
+	path := []int{jobID}
+	visited := map[int]bool{jobID: true}
+	current := upstreamJobID
+
+	for {
+		if current == jobID {
+			return path, nil
+		}
+		if visited[current] {
+			// there is already a cycle upstream that doesn't include this job
+			return nil, nil
+		}
+		visited[current] = true
+		path = append(path, current)
+
+		job, err := getJob(current)
+		if err != nil {
+			return nil, err
+		}
+		if job == nil || job.JobCompletionTrigger == nil {
+			return nil, nil
+		}
+		current = job.JobCompletionTrigger.Condition.JobID
+	}
+}
@@ -0,0 +1,148 @@
+package job
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func testGraphJob(jobID int, triggeredBy int, deferringJobID int, deferringEnvironmentID int) dbt_cloud.JobWithEnvironment {
+	job := dbt_cloud.JobWithEnvironment{
+		Job: dbt_cloud.Job{
+			ID:        &jobID,
+			ProjectId: 1,
+			Name:      fmt.Sprintf("job %d", jobID),
+		},
+	}
+	if triggeredBy != 0 {
+		job.JobCompletionTrigger = &dbt_cloud.JobCompletionTrigger{
+			Condition: dbt_cloud.JobCompletionTriggerCondition{
+				JobID:     triggeredBy,
+				ProjectID: 1,
+				Statuses:  []int{10},
+			},
+		}
+	}
+	if deferringJobID != 0 {
+		job.DeferringJobId = &deferringJobID
+	}
+	if deferringEnvironmentID != 0 {
+		job.DeferringEnvironmentId = &deferringEnvironmentID
+	}
+	return job
+}
+
+func TestBuildJobGraph(t *testing.T) {
+	tests := []struct {
+		name       string
+		jobs       []dbt_cloud.JobWithEnvironment
+		edgesCount int
+		roots      []int
+		leaves     []int
+		cycles     [][]int
+	}{
+		{
+			name: "chain of jobs",
+			jobs: []dbt_cloud.JobWithEnvironment{
+				testGraphJob(3, 2, 0, 0),
+				testGraphJob(1, 0, 0, 0),
+				testGraphJob(2, 1, 0, 0),
+			},
+			edgesCount: 2,
+			roots:      []int{1},
+			leaves:     []int{3},
+			cycles:     [][]int{},
+		},
+		{
+			name: "deferral and self deferral",
+			jobs: []dbt_cloud.JobWithEnvironment{
+				testGraphJob(1, 0, 1, 0),
+				testGraphJob(2, 0, 1, 0),
+				testGraphJob(3, 0, 0, 100),
+			},
+			edgesCount: 2,
+			roots:      []int{1, 3},
+			leaves:     []int{2, 3},
+			cycles:     [][]int{},
+		},
+		{
+			name: "trigger from a job outside of the graph",
+			jobs: []dbt_cloud.JobWithEnvironment{
+				testGraphJob(1, 99, 0, 0),
+			},
+			edgesCount: 1,
+			roots:      []int{1},
+			leaves:     []int{1},
+			cycles:     [][]int{},
+		},
+		{
+			name: "cycle with a job triggered by the cycle",
+			jobs: []dbt_cloud.JobWithEnvironment{
+				testGraphJob(1, 3, 0, 0),
+				testGraphJob(2, 1, 0, 0),
+				testGraphJob(3, 2, 0, 0),
+				testGraphJob(4, 3, 0, 0),
+			},
+			edgesCount: 4,
+			roots:      []int{},
+			leaves:     []int{4},
+			cycles:     [][]int{{1, 3, 2}},
+		},
+		{
+			name: "job triggering itself",
+			jobs: []dbt_cloud.JobWithEnvironment{
+				testGraphJob(1, 1, 0, 0),
+			},
+			edgesCount: 1,
+			roots:      []int{},
+			leaves:     []int{},
+			cycles:     [][]int{{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := buildJobGraph(tt.jobs)
+
+			assert.Len(t, graph.Edges, tt.edgesCount)
+			assert.ElementsMatch(t, tt.roots, graph.Roots)
+			assert.ElementsMatch(t, tt.leaves, graph.Leaves)
+			assert.Equal(t, tt.cycles, graph.Cycles)
+		})
+	}
+}
+
+func TestFindNewTriggerCycle(t *testing.T) {
+	// job 2 is triggered by 3, job 3 by 4, and job 5 by 6 which is triggered by 5
+	existingJobs := map[int]dbt_cloud.JobWithEnvironment{
+		2: testGraphJob(2, 3, 0, 0),
+		3: testGraphJob(3, 4, 0, 0),
+		4: testGraphJob(4, 0, 0, 0),
+		5: testGraphJob(5, 6, 0, 0),
+		6: testGraphJob(6, 5, 0, 0),
+	}
+	getJob := func(jobID int) (*dbt_cloud.Job, error) {
+		job, ok := existingJobs[jobID]
+		if !ok {
+			return nil, fmt.Errorf("resource-not-found: job %d", jobID)
+		}
+		return &job.Job, nil
+	}
+
+	cycle, err := findNewTriggerCycle(4, 2, getJob)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 2, 3}, cycle)
+
+	cycle, err = findNewTriggerCycle(1, 2, getJob)
+	assert.NoError(t, err)
+	assert.Nil(t, cycle)
+
+	cycle, err = findNewTriggerCycle(1, 5, getJob)
+	assert.NoError(t, err)
+	assert.Nil(t, cycle)
+
+	_, err = findNewTriggerCycle(1, 42, getJob)
+	assert.Error(t, err)
+}
@@ -69,6 +69,8 @@ type JobDataSourceModel struct {
 	Environment                   *JobEnvironment       `tfsdk:"environment"`
 	JobCompletionTriggerCondition *JobCompletionTrigger `tfsdk:"job_completion_trigger_condition"`
 	RunCompareChanges             types.Bool            `tfsdk:"run_compare_changes"`
+	ForceNodeSelection            types.Bool            `tfsdk:"force_node_selection"`
+	CostOptimizationFeatures      []types.String        `tfsdk:"cost_optimization_features"`
 }
 
 // TODO remove this in the next major release
@@ -96,6 +98,18 @@ type SingleJobDataSourceModel struct {
 	Environment                   *JobEnvironment                  `tfsdk:"environment"`
 	JobCompletionTriggerCondition []*JobCompletionTriggerCondition `tfsdk:"job_completion_trigger_condition"`
 	RunCompareChanges             types.Bool                       `tfsdk:"run_compare_changes"`
+	ForceNodeSelection            types.Bool                       `tfsdk:"force_node_selection"`
+	CostOptimizationFeatures      []types.String                   `tfsdk:"cost_optimization_features"`
+	IncludeLatestRuns             types.Bool                       `tfsdk:"include_latest_runs"`
+	LatestRun                     *JobRun                          `tfsdk:"latest_run"`
+	LatestSuccessfulRun           *JobRun                          `tfsdk:"latest_successful_run"`
+}
+
+type JobRun struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	FinishedAt types.String `tfsdk:"finished_at"`
+	GitSHA     types.String `tfsdk:"git_sha"`
 }
 
 type JobResourceModel struct {
@@ -119,18 +133,74 @@ type JobResourceModel struct {
 	TriggersOnDraftPr types.Bool   `tfsdk:"triggers_on_draft_pr"` // exists
 	// Environment                   *JobEnvironment       `tfsdk:"environment"`
 	JobCompletionTriggerCondition []*JobCompletionTriggerCondition `tfsdk:"job_completion_trigger_condition"` // exists
-	RunCompareChanges             types.Bool            `tfsdk:"run_compare_changes"`              // exists
-	IsActive                      types.Bool            `tfsdk:"is_active"`
-	TargetName                    types.String          `tfsdk:"target_name"` // add deprecated
-	NumThreads                    types.Int64           `tfsdk:"num_threads"` // add deprecated moved to settings
-	RunLint                       types.Bool            `tfsdk:"run_lint"`
-	ErrorsOnLintFailure           types.Bool            `tfsdk:"errors_on_lint_failure"`
-	ScheduleType                  types.String          `tfsdk:"schedule_type"`
-	ScheduleInterval              types.Int64           `tfsdk:"schedule_interval"`
-	ScheduleHours                 []types.Int64         `tfsdk:"schedule_hours"`
-	ScheduleDays                  []types.Int64         `tfsdk:"schedule_days"`
-	ScheduleCron                  types.String          `tfsdk:"schedule_cron"`    // add deprecated move to schedule
-	DeferringJobId                types.Int64           `tfsdk:"deferring_job_id"` // add deprecated move to deferring_job_definition_id
-	SelfDeferring                 types.Bool            `tfsdk:"self_deferring"`
-	CompareChangesFlags           types.String          `tfsdk:"compare_changes_flags"`
+	RunCompareChanges             types.Bool                       `tfsdk:"run_compare_changes"`              // exists
+	IsActive                      types.Bool                       `tfsdk:"is_active"`
+	TargetName                    types.String                     `tfsdk:"target_name"` // add deprecated
+	NumThreads                    types.Int64                      `tfsdk:"num_threads"` // add deprecated moved to settings
+	RunLint                       types.Bool                       `tfsdk:"run_lint"`
+	ErrorsOnLintFailure           types.Bool                       `tfsdk:"errors_on_lint_failure"`
+	ScheduleType                  types.String                     `tfsdk:"schedule_type"`
+	ScheduleInterval              types.Int64                      `tfsdk:"schedule_interval"`
+	ScheduleHours                 []types.Int64                    `tfsdk:"schedule_hours"`
+	ScheduleDays                  []types.Int64                    `tfsdk:"schedule_days"`
+	ScheduleCron                  types.String                     `tfsdk:"schedule_cron"`    // add deprecated move to schedule
+	DeferringJobId                types.Int64                      `tfsdk:"deferring_job_id"` // add deprecated move to deferring_job_definition_id
+	SelfDeferring                 types.Bool                       `tfsdk:"self_deferring"`
+	CompareChangesFlags           types.String                     `tfsdk:"compare_changes_flags"`
+	EnvVarOverrides               types.Map                        `tfsdk:"env_var_overrides"`
+	ForceNodeSelection            types.Bool                       `tfsdk:"force_node_selection"`
+	CostOptimizationFeatures      types.Set                        `tfsdk:"cost_optimization_features"`
+}
+
+type JobGraphDataSourceModel struct {
+	ProjectIDs []types.Int64   `tfsdk:"project_ids"`
+	Nodes      []JobGraphNode  `tfsdk:"nodes"`
+	Edges      []JobGraphEdge  `tfsdk:"edges"`
+	Roots      []types.Int64   `tfsdk:"roots"`
+	Leaves     []types.Int64   `tfsdk:"leaves"`
+	Cycles     []JobGraphCycle `tfsdk:"cycles"`
+	HasCycles  types.Bool      `tfsdk:"has_cycles"`
+}
+
+type JobGraphNode struct {
+	JobID                  types.Int64  `tfsdk:"job_id"`
+	Name                   types.String `tfsdk:"name"`
+	ProjectID              types.Int64  `tfsdk:"project_id"`
+	EnvironmentID          types.Int64  `tfsdk:"environment_id"`
+	JobType                types.String `tfsdk:"job_type"`
+	DeferringJobID         types.Int64  `tfsdk:"deferring_job_definition_id"`
+	DeferringEnvironmentID types.Int64  `tfsdk:"deferring_environment_id"`
+}
+
+type JobGraphEdge struct {
+	Type                  types.String `tfsdk:"type"`
+	UpstreamJobID         types.Int64  `tfsdk:"upstream_job_id"`
+	UpstreamEnvironmentID types.Int64  `tfsdk:"upstream_environment_id"`
+	DownstreamJobID       types.Int64  `tfsdk:"downstream_job_id"`
+}
+
+type JobGraphCycle struct {
+	JobIDs []types.Int64 `tfsdk:"job_ids"`
+}
+
+type JobCopyResourceModel struct {
+	ID                     types.Int64    `tfsdk:"id"`
+	JobID                  types.Int64    `tfsdk:"job_id"`
+	SourceJobID            types.Int64    `tfsdk:"source_job_id"`
+	ProjectID              types.Int64    `tfsdk:"project_id"`
+	EnvironmentID          types.Int64    `tfsdk:"environment_id"`
+	SyncWithSource         types.Bool     `tfsdk:"sync_with_source"`
+	CopiedSettingsHash     types.String   `tfsdk:"copied_settings_hash"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	ExecuteSteps           []types.String `tfsdk:"execute_steps"`
+	DbtVersion             types.String   `tfsdk:"dbt_version"`
+	NumThreads             types.Int64    `tfsdk:"num_threads"`
+	TargetName             types.String   `tfsdk:"target_name"`
+	TimeoutSeconds         types.Int64    `tfsdk:"timeout_seconds"`
+	GenerateDocs           types.Bool     `tfsdk:"generate_docs"`
+	RunGenerateSources     types.Bool     `tfsdk:"run_generate_sources"`
+	ScheduleCron           types.String   `tfsdk:"schedule_cron"`
+	Triggers               *JobTriggers   `tfsdk:"triggers"`
+	DeferringEnvironmentID types.Int64    `tfsdk:"deferring_environment_id"`
 }
@@ -10,16 +10,19 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 )
 
 var (
-	_ resource.Resource                = &jobResource{}
-	_ resource.ResourceWithConfigure   = &jobResource{}
-	_ resource.ResourceWithImportState = &jobResource{}
-	_ resource.ResourceWithModifyPlan  = &jobResource{}
+	_ resource.Resource                   = &jobResource{}
+	_ resource.ResourceWithConfigure      = &jobResource{}
+	_ resource.ResourceWithImportState    = &jobResource{}
+	_ resource.ResourceWithModifyPlan     = &jobResource{}
+	_ resource.ResourceWithValidateConfig = &jobResource{}
 )
 
 type jobResource struct {
@@ -40,6 +43,10 @@ func (j *jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 		return
 	}
 
+	j.warnOnJobCompletionTriggerCycle(plan, state, resp)
+	j.warnOnEnvVarOverridesConflict(ctx, req, plan, state, resp)
+	resetForceNodeSelection(ctx, req, resp)
+
 	// Skip checks if necessary fields are null
 	if plan.Triggers == nil || state.Triggers == nil {
 		return
@@ -74,6 +81,112 @@ func (j *jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 	}
 }
 
+// warnOnJobCompletionTriggerCycle adds a warning when the job completion trigger being set would create a cycle,
+// e.g. job A triggered by job B which is itself triggered by job A.
+// New jobs can't be triggering other jobs yet, so this only needs checking on updates.
+func (j *jobResource) warnOnJobCompletionTriggerCycle(
+	plan JobResourceModel,
+	state JobResourceModel,
+	resp *resource.ModifyPlanResponse,
+) {
+	if j.client == nil || len(plan.JobCompletionTriggerCondition) == 0 {
+		return
+	}
+
+	planCondition := plan.JobCompletionTriggerCondition[0]
+	if planCondition.JobID.IsUnknown() || planCondition.JobID.IsNull() || state.ID.IsNull() {
+		return
+	}
+
+	if len(state.JobCompletionTriggerCondition) != 0 &&
+		state.JobCompletionTriggerCondition[0].JobID.Equal(planCondition.JobID) {
+		return
+	}
+
+	jobID := int(state.ID.ValueInt64())
+	cycle, err := findNewTriggerCycle(
+		jobID,
+		int(planCondition.JobID.ValueInt64()),
+		func(upstreamJobID int) (*dbt_cloud.Job, error) {
+			return j.client.GetJob(strconv.Itoa(upstreamJobID))
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("job_completion_trigger_condition"),
+			"Unable to check for job completion trigger cycles",
+			fmt.Sprintf("Could not retrieve the upstream jobs of job %d: %s", jobID, err.Error()),
+		)
+		return
+	}
+
+	if cycle != nil {
+		cycleStr := lo.Map(cycle, func(id int, _ int) string {
+			return strconv.Itoa(id)
+		})
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("job_completion_trigger_condition"),
+			"Job completion trigger cycle",
+			fmt.Sprintf(
+				"The job completion trigger of job %d creates a cycle and the jobs would keep triggering each other (each job is triggered by the next one): %s <- %d",
+				jobID,
+				strings.Join(cycleStr, " <- "),
+				jobID,
+			),
+		)
+	}
+}
+
+// ValidateConfig checks that the settings only available for some types of jobs are not used with other types
+func (j *jobResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config JobResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateEnvVarOverridesNotSecret(config.EnvVarOverrides, &resp.Diagnostics)
+
+	jobType := ""
+	if config.Triggers != nil {
+		if config.Triggers.OnMerge.ValueBool() {
+			jobType = "merge"
+		}
+		if config.Triggers.GithubWebhook.ValueBool() || config.Triggers.GitProviderWebhook.ValueBool() {
+			jobType = "ci"
+		}
+	}
+	if !config.JobType.IsNull() && !config.JobType.IsUnknown() {
+		jobType = config.JobType.ValueString()
+	}
+
+	if jobType != "ci" && jobType != "merge" {
+		return
+	}
+
+	if !config.ForceNodeSelection.IsNull() && !config.ForceNodeSelection.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force_node_selection"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("force_node_selection is only supported for deploy jobs and can't be set for %s jobs.", jobType),
+		)
+	}
+
+	if !config.CostOptimizationFeatures.IsNull() && !config.CostOptimizationFeatures.IsUnknown() &&
+		len(config.CostOptimizationFeatures.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cost_optimization_features"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("cost_optimization_features is only supported for deploy jobs and can't be set for %s jobs.", jobType),
+		)
+	}
+}
+
 func JobResource() resource.Resource {
 	return &jobResource{}
 }
@@ -175,6 +288,17 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	compareChangesFlags := plan.CompareChangesFlags.ValueString()
 
+	var forceNodeSelection *bool
+	if !plan.ForceNodeSelection.IsNull() && !plan.ForceNodeSelection.IsUnknown() {
+		forceNodeSelection = plan.ForceNodeSelection.ValueBoolPointer()
+	}
+
+	var costOptimizationFeatures *[]string
+	if !plan.CostOptimizationFeatures.IsNull() && !plan.CostOptimizationFeatures.IsUnknown() {
+		features := helper.StringSetToStringSlice(plan.CostOptimizationFeatures)
+		costOptimizationFeatures = &features
+	}
+
 	var jobCompletionTriggerCondition map[string]any
 
 	if len(plan.JobCompletionTriggerCondition) != 0 {
@@ -231,6 +355,8 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		errorsOnLintFailure,
 		jobType,
 		compareChangesFlags,
+		forceNodeSelection,
+		costOptimizationFeatures,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -274,6 +400,24 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		createdSelfDeferring = strconv.Itoa(*createdJob.DeferringJobId) == jobIDStr
 	}
 	plan.SelfDeferring = types.BoolValue(createdSelfDeferring)
+	plan.ForceNodeSelection = types.BoolPointerValue(createdJob.ForceNodeSelection)
+	plan.CostOptimizationFeatures = costOptimizationFeaturesToSet(createdJob.CostOptimizationFeatures)
+	resp.Diagnostics.Append(setForceNodeSelectionConfigured(ctx, req.Config, resp.Private)...)
+
+	if !plan.EnvVarOverrides.IsNull() {
+		resp.Diagnostics.Append(j.reconcileEnvVarOverrides(
+			ctx,
+			int(projectId.ValueInt64()),
+			*createdJob.ID,
+			plan.EnvVarOverrides,
+			resp.Private,
+		)...)
+		if resp.Diagnostics.HasError() {
+			// the job was created, so we save it in the state to not lose track of it
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
 
 	diags := resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -475,6 +619,25 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		state.SelfDeferring = types.BoolValue(selfDeferring)
 	}
 
+	state.ForceNodeSelection = types.BoolPointerValue(retrievedJob.ForceNodeSelection)
+	state.CostOptimizationFeatures = costOptimizationFeaturesToSet(retrievedJob.CostOptimizationFeatures)
+
+	// the overrides are only managed by the job when `env_var_overrides` is set
+	if !state.EnvVarOverrides.IsNull() {
+		envVarOverrides, names, diags := j.readEnvVarOverrides(ctx, retrievedJob.ProjectId, *retrievedJob.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.EnvVarOverrides = envVarOverrides
+
+		_, found, diags := getManagedEnvVarOverrides(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if !found {
+			resp.Diagnostics.Append(setManagedEnvVarOverrides(ctx, resp.Private, names)...)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -625,6 +788,14 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	job.ErrorsOnLintFailure = plan.ErrorsOnLintFailure.ValueBool()
 	job.CompareChangesFlags = plan.CompareChangesFlags.ValueString()
 
+	if !plan.ForceNodeSelection.IsNull() && !plan.ForceNodeSelection.IsUnknown() {
+		job.ForceNodeSelection = plan.ForceNodeSelection.ValueBoolPointer()
+	}
+	if !plan.CostOptimizationFeatures.IsNull() && !plan.CostOptimizationFeatures.IsUnknown() {
+		features := helper.StringSetToStringSlice(plan.CostOptimizationFeatures)
+		job.CostOptimizationFeatures = &features
+	}
+
 	// Capture what's changing for better error messages
 	oldEnvID := state.EnvironmentID.ValueInt64()
 	newEnvID := plan.EnvironmentID.ValueInt64()
@@ -670,6 +841,23 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		plan.JobType = types.StringNull()
 	}
 
+	if !plan.EnvVarOverrides.IsNull() {
+		resp.Diagnostics.Append(j.reconcileEnvVarOverrides(
+			ctx,
+			int(plan.ProjectID.ValueInt64()),
+			int(jobID),
+			plan.EnvVarOverrides,
+			resp.Private,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ForceNodeSelection = types.BoolPointerValue(updatedJob.ForceNodeSelection)
+	plan.CostOptimizationFeatures = costOptimizationFeaturesToSet(updatedJob.CostOptimizationFeatures)
+	resp.Diagnostics.Append(setForceNodeSelectionConfigured(ctx, req.Config, resp.Private)...)
+
 	updatedJobIDStr := strconv.FormatInt(jobID, 10)
 	updatedSelfDeferring := updatedJob.DeferringJobId != nil && strconv.Itoa(*updatedJob.DeferringJobId) == updatedJobIDStr
 	plan.SelfDeferring = types.BoolValue(updatedSelfDeferring)
@@ -680,3 +868,13 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 }
+
+func costOptimizationFeaturesToSet(features *[]string) types.Set {
+	if features == nil {
+		return types.SetNull(types.StringType)
+	}
+	elements := lo.Map(*features, func(feature string, _ int) attr.Value {
+		return types.StringValue(feature)
+	})
+	return types.SetValueMust(types.StringType, elements)
+}
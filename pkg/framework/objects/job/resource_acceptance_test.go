@@ -973,3 +973,194 @@ resource "dbtcloud_job" "test_job" {
 }
 `, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, jobName, interval, daysStr)
 }
+
+func TestAccDbtCloudJobResourceEnvVarOverrides(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobResourceEnvVarOverridesConfig(
+					jobName,
+					projectName,
+					environmentName,
+					`{
+    DBT_VAR_1 = "override_1"
+    DBT_VAR_2 = "override_2"
+  }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "env_var_overrides.%", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "env_var_overrides.DBT_VAR_1", "override_1"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "env_var_overrides.DBT_VAR_2", "override_2"),
+				),
+			},
+			// update one override and delete the other one
+			{
+				Config: testAccDbtCloudJobResourceEnvVarOverridesConfig(
+					jobName,
+					projectName,
+					environmentName,
+					`{
+    DBT_VAR_1 = "override_1_new"
+  }`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "env_var_overrides.%", "1"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "env_var_overrides.DBT_VAR_1", "override_1_new"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobResourceEnvVarOverridesConfig(
+	jobName, projectName, environmentName, envVarOverrides string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+  project_id  = dbtcloud_project.test_job_project.id
+  name        = "%s"
+  dbt_version = "%s"
+  type        = "deployment"
+}
+
+resource "dbtcloud_environment_variable" "test_env_var_1" {
+  name       = "DBT_VAR_1"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_values = {
+    "project" : "project_1"
+  }
+}
+
+resource "dbtcloud_environment_variable" "test_env_var_2" {
+  name       = "DBT_VAR_2"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_values = {
+    "project" : "project_2"
+  }
+}
+
+resource "dbtcloud_job" "test_job" {
+  name           = "%s"
+  project_id     = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+  env_var_overrides = %s
+  depends_on = [
+    dbtcloud_environment_variable.test_env_var_1,
+    dbtcloud_environment_variable.test_env_var_2,
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, jobName, envVarOverrides)
+}
+
+func TestAccDbtCloudJobResourceCostOptimization(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobResourceCostOptimizationConfig(
+					jobName,
+					projectName,
+					environmentName,
+					false,
+					true,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "force_node_selection", "false"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "cost_optimization_features.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"dbtcloud_job.test_job",
+						"cost_optimization_features.*",
+						"state_aware_orchestration",
+					),
+				),
+			},
+			// the settings are not supported for CI jobs
+			{
+				Config: testAccDbtCloudJobResourceCostOptimizationConfig(
+					jobName,
+					projectName,
+					environmentName,
+					true,
+					true,
+				),
+				ExpectError: regexp.MustCompile("only supported for deploy jobs"),
+			},
+			// removing force_node_selection from the config resets it
+			{
+				Config: testAccDbtCloudJobResourceCostOptimizationConfig(
+					jobName,
+					projectName,
+					environmentName,
+					false,
+					false,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "force_node_selection", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobResourceCostOptimizationConfig(
+	jobName, projectName, environmentName string,
+	ciJob bool,
+	withForceNodeSelection bool,
+) string {
+	forceNodeSelection := ""
+	if withForceNodeSelection {
+		forceNodeSelection = "force_node_selection       = false"
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+  project_id  = dbtcloud_project.test_job_project.id
+  name        = "%s"
+  dbt_version = "latest-fusion"
+  type        = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name           = "%s"
+  project_id     = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : %t,
+    "schedule" : false,
+  }
+  %s
+  cost_optimization_features = ["state_aware_orchestration"]
+}
+`, projectName, environmentName, jobName, ciJob, forceNodeSelection)
+}
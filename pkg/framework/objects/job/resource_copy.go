@@ -0,0 +1,383 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &jobCopyResource{}
+	_ resource.ResourceWithConfigure   = &jobCopyResource{}
+	_ resource.ResourceWithImportState = &jobCopyResource{}
+	_ resource.ResourceWithModifyPlan  = &jobCopyResource{}
+)
+
+func JobCopyResource() resource.Resource {
+	return &jobCopyResource{}
+}
+
+type jobCopyResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *jobCopyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_copy"
+}
+
+func (r *jobCopyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// ModifyPlan computes the hash of the settings copied from the source job when the copy is kept in sync with it,
+// so that any change in the source job is planned as an update of the copy.
+func (r *jobCopyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Don't do anything on resource creation or deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state JobCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SyncWithSource.ValueBool() && r.client != nil && !plan.SourceJobID.IsUnknown() {
+		sourceJob, err := r.client.GetJob(strconv.FormatInt(plan.SourceJobID.ValueInt64(), 10))
+		if err == nil {
+			var sourceHash string
+			sourceHash, err = jobCopySettingsHash(*sourceJob, plan)
+			if err == nil {
+				resp.Diagnostics.Append(
+					resp.Plan.SetAttribute(ctx, path.Root("copied_settings_hash"), sourceHash)...,
+				)
+				return
+			}
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("sync_with_source"),
+			"Unable to check if the job copy is in sync with its source",
+			fmt.Sprintf("Could not retrieve the source job %d: %s", plan.SourceJobID.ValueInt64(), err.Error()),
+		)
+	}
+
+	// the settings copied from the source job can change when the overridden fields change
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("copied_settings_hash"), types.StringUnknown())...,
+		)
+	}
+}
+
+func (r *jobCopyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan JobCopyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceJobIDStr := strconv.FormatInt(plan.SourceJobID.ValueInt64(), 10)
+	sourceJob, err := r.client.GetJob(sourceJobIDStr)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving the source job",
+			"Could not retrieve job with ID "+sourceJobIDStr+": "+err.Error(),
+		)
+		return
+	}
+
+	projectID := sourceJob.ProjectId
+	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.IsNull() {
+		projectID = int(plan.ProjectID.ValueInt64())
+	}
+
+	newJob, selfDeferring := newJobCopy(*sourceJob, projectID, int(plan.EnvironmentID.ValueInt64()))
+	applyJobCopyOverrides(&newJob, plan)
+
+	createdJob, err := r.client.CreateJobFromDefinition(newJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the job copy",
+			"Could not copy job "+sourceJobIDStr+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if createdJob.ID == nil {
+		resp.Diagnostics.AddError(
+			"Error creating the job copy",
+			"Job creation returned a response without a job ID. This may indicate a permissions issue or an API problem.",
+		)
+		return
+	}
+
+	if selfDeferring {
+		createdJobIDStr := strconv.Itoa(*createdJob.ID)
+		createdJob.DeferringJobId = createdJob.ID
+		createdJob, err = r.client.UpdateJob(createdJobIDStr, *createdJob)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating the job copy",
+				"Could not set the self deferral of job "+createdJobIDStr+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.Int64Value(int64(*createdJob.ID))
+	plan.JobID = types.Int64Value(int64(*createdJob.ID))
+	plan.ProjectID = types.Int64Value(int64(createdJob.ProjectId))
+
+	copiedSettingsHash, err := jobCopySettingsHash(*createdJob, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error computing the hash of the copied settings", err.Error())
+		return
+	}
+	plan.CopiedSettingsHash = types.StringValue(copiedSettingsHash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *jobCopyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state JobCopyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobIDStr := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	retrievedJob, err := r.client.GetJob(jobIDStr)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The job copy was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the job copy", err.Error())
+		return
+	}
+
+	if retrievedJob.State == dbt_cloud.STATE_DELETED {
+		resp.Diagnostics.AddWarning(
+			"Resource not found",
+			"The job copy was deleted and has been removed from the state.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.Int64Value(int64(*retrievedJob.ID))
+	state.JobID = types.Int64Value(int64(*retrievedJob.ID))
+	state.ProjectID = types.Int64Value(int64(retrievedJob.ProjectId))
+	state.EnvironmentID = types.Int64Value(int64(retrievedJob.EnvironmentId))
+
+	// only the overridden fields are tracked, the other ones are copied from the source job
+	if !state.Name.IsNull() {
+		state.Name = types.StringValue(retrievedJob.Name)
+	}
+	if !state.Description.IsNull() {
+		state.Description = types.StringValue(retrievedJob.Description)
+	}
+	if state.ExecuteSteps != nil {
+		state.ExecuteSteps = helper.SliceStringToSliceTypesString(retrievedJob.ExecuteSteps)
+	}
+	if !state.DbtVersion.IsNull() {
+		state.DbtVersion = types.StringPointerValue(retrievedJob.DbtVersion)
+	}
+	if !state.NumThreads.IsNull() {
+		state.NumThreads = types.Int64Value(int64(retrievedJob.Settings.Threads))
+	}
+	if !state.TargetName.IsNull() {
+		state.TargetName = types.StringValue(retrievedJob.Settings.TargetName)
+	}
+	if !state.TimeoutSeconds.IsNull() {
+		state.TimeoutSeconds = types.Int64Value(int64(retrievedJob.Execution.TimeoutSeconds))
+	}
+	if !state.GenerateDocs.IsNull() {
+		state.GenerateDocs = types.BoolValue(retrievedJob.GenerateDocs)
+	}
+	if !state.RunGenerateSources.IsNull() {
+		state.RunGenerateSources = types.BoolValue(retrievedJob.RunGenerateSources)
+	}
+	if !state.ScheduleCron.IsNull() {
+		state.ScheduleCron = types.StringPointerValue(retrievedJob.Schedule.Date.Cron)
+	}
+	if state.Triggers != nil {
+		state.Triggers = &JobTriggers{
+			GithubWebhook:      types.BoolValue(retrievedJob.Triggers.GithubWebhook),
+			GitProviderWebhook: types.BoolValue(retrievedJob.Triggers.GitProviderWebhook),
+			Schedule:           types.BoolValue(retrievedJob.Triggers.Schedule),
+			OnMerge:            types.BoolValue(retrievedJob.Triggers.OnMerge),
+		}
+	}
+	if !state.DeferringEnvironmentID.IsNull() {
+		state.DeferringEnvironmentID = types.Int64PointerValue(
+			helper.IntPointerToInt64Pointer(retrievedJob.DeferringEnvironmentId),
+		)
+	}
+
+	copiedSettingsHash, err := jobCopySettingsHash(*retrievedJob, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error computing the hash of the copied settings", err.Error())
+		return
+	}
+	state.CopiedSettingsHash = types.StringValue(copiedSettingsHash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jobCopyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state JobCopyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := int(state.ID.ValueInt64())
+	jobIDStr := strconv.Itoa(jobID)
+
+	var job *dbt_cloud.Job
+	var err error
+	if plan.SyncWithSource.ValueBool() {
+		// the whole job is copied again from the source to get it back in sync
+		sourceJobIDStr := strconv.FormatInt(plan.SourceJobID.ValueInt64(), 10)
+		job, err = r.client.GetJob(sourceJobIDStr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error retrieving the source job",
+				"Could not retrieve job with ID "+sourceJobIDStr+": "+err.Error(),
+			)
+			return
+		}
+		syncedJob, selfDeferring := newJobCopy(*job, int(plan.ProjectID.ValueInt64()), int(plan.EnvironmentID.ValueInt64()))
+		syncedJob.ID = &jobID
+		if selfDeferring {
+			syncedJob.DeferringJobId = &jobID
+		}
+		job = &syncedJob
+	} else {
+		job, err = r.client.GetJob(jobIDStr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error retrieving the job copy",
+				"Could not retrieve job with ID "+jobIDStr+": "+err.Error(),
+			)
+			return
+		}
+		job.EnvironmentId = int(plan.EnvironmentID.ValueInt64())
+	}
+
+	applyJobCopyOverrides(job, plan)
+
+	updatedJob, err := r.client.UpdateJob(jobIDStr, *job)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating the job copy",
+			"Could not update job with ID "+jobIDStr+": "+err.Error(),
+		)
+		return
+	}
+
+	copiedSettingsHash, err := jobCopySettingsHash(*updatedJob, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error computing the hash of the copied settings", err.Error())
+		return
+	}
+	plan.CopiedSettingsHash = types.StringValue(copiedSettingsHash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *jobCopyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state JobCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobIDStr := strconv.FormatInt(state.ID.ValueInt64(), 10)
+
+	job, err := r.client.GetJob(jobIDStr)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", "Unable to retrieve job before deletion: "+err.Error())
+		return
+	}
+
+	job.State = dbt_cloud.STATE_DELETED
+	_, err = r.client.UpdateJob(jobIDStr, *job)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to delete job: "+err.Error())
+		return
+	}
+}
+
+func (r *jobCopyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	sourceJobID, jobID, err := helper.SplitIDToInts(req.ID, "dbtcloud_job_copy")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the import ID",
+			fmt.Sprintf("Expected the import ID in the format <source_job_id>:<job_id>: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), jobID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("job_id"), jobID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_job_id"), sourceJobID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_with_source"), false)...)
+}
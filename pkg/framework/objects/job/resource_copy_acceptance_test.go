@@ -0,0 +1,122 @@
+package job_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudJobCopyResource(t *testing.T) {
+
+	jobName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	jobCopyName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobCopyResourceConfig(projectName, jobName, jobCopyName, "dbt build", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_job_copy.test_job_copy", "job_id"),
+					resource.TestCheckResourceAttr("dbtcloud_job_copy.test_job_copy", "name", jobCopyName),
+					resource.TestCheckResourceAttr("dbtcloud_job_copy.test_job_copy", "num_threads", "8"),
+					resource.TestCheckNoResourceAttr("dbtcloud_job_copy.test_job_copy", "execute_steps.#"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_job_copy.test_job_copy",
+						"project_id",
+						"dbtcloud_project.test_project",
+						"id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_job_copy.test_job_copy",
+						"environment_id",
+						"dbtcloud_environment.test_environment_copy",
+						"environment_id",
+					),
+				),
+			},
+			// changing the source job, with the copy in sync
+			{
+				Config: testAccDbtCloudJobCopyResourceConfig(projectName, jobName, jobCopyName, "dbt test", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job_copy.test_job_copy", "sync_with_source", "true"),
+					resource.TestCheckResourceAttrSet("dbtcloud_job_copy.test_job_copy", "copied_settings_hash"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_job_copy.test_job_copy",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccDbtCloudJobCopyImportStateIdFunc("dbtcloud_job_copy.test_job_copy"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "num_threads", "sync_with_source", "copied_settings_hash"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobCopyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["source_job_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDbtCloudJobCopyResourceConfig(
+	projectName, jobName, jobCopyName, sourceStep string,
+	syncWithSource bool,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+    name = "%s"
+}
+
+resource "dbtcloud_environment" "test_environment" {
+    project_id = dbtcloud_project.test_project.id
+    name = "PROD %s"
+    dbt_version = "%s"
+    type = "deployment"
+    deployment_type = "production"
+}
+
+resource "dbtcloud_environment" "test_environment_copy" {
+    project_id = dbtcloud_project.test_project.id
+    name = "STAGING %s"
+    dbt_version = "%s"
+    type = "deployment"
+    deployment_type = "staging"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_environment.environment_id
+  execute_steps = [
+    "%s"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+    "on_merge": false
+  }
+}
+
+resource "dbtcloud_job_copy" "test_job_copy" {
+  source_job_id = dbtcloud_job.test_job.id
+  environment_id = dbtcloud_environment.test_environment_copy.environment_id
+  name = "%s"
+  num_threads = 8
+  sync_with_source = %t
+}
+`, projectName, projectName, acctest_config.DBT_CLOUD_VERSION, projectName, acctest_config.DBT_CLOUD_VERSION, jobName, sourceStep, jobCopyName, syncWithSource)
+}
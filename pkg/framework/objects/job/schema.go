@@ -2,10 +2,14 @@ package job
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -13,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -50,6 +56,15 @@ func getJobAttributes() map[string]schema.Attribute {
 			Computed:    true,
 			Description: "Whether the job should compare data changes introduced by the code change in the PR",
 		},
+		"force_node_selection": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the job runs all the selected nodes (true) or only the ones with changes when state-aware orchestration is used (false)",
+		},
+		"cost_optimization_features": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The cost optimization features enabled for the job",
+		},
 		"id": schema.Int64Attribute{
 			Computed:    true,
 			Description: "The ID of the job",
@@ -192,6 +207,27 @@ func (d jobsDataSource) ValidateConfig(
 	}
 }
 
+func getJobRunAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the run",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the run, one of `queued`, `starting`, `running`, `success`, `error` or `cancelled`",
+		},
+		"finished_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the run finished, empty if it is still running",
+		},
+		"git_sha": schema.StringAttribute{
+			Computed:    true,
+			Description: "The git SHA of the commit used for the run",
+		},
+	}
+}
+
 func (j *jobDataSource) Schema(
 	ctx context.Context,
 	req datasource.SchemaRequest,
@@ -200,8 +236,44 @@ func (j *jobDataSource) Schema(
 	jobAttributes := getJobAttributes()
 
 	jobAttributes["job_id"] = schema.Int64Attribute{
-		Required:    true,
-		Description: "The ID of the job",
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the job. Either `job_id` or `project_id`, `environment_id` and `name` must be set",
+	}
+
+	jobAttributes["project_id"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the project the job is in. Used with `environment_id` and `name` to look up the job when `job_id` is not set",
+	}
+
+	jobAttributes["environment_id"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the environment the job is in. Used with `project_id` and `name` to look up the job when `job_id` is not set",
+	}
+
+	jobAttributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the job. Used with `project_id` and `environment_id` to look up the job when `job_id` is not set, in which case the name must be unique in the environment",
+	}
+
+	jobAttributes["include_latest_runs"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to retrieve `latest_run` and `latest_successful_run`, which requires additional API calls. Defaults to `false`",
+	}
+
+	jobAttributes["latest_run"] = schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The most recent run of the job, whatever its status. Null if the job has never run or if `include_latest_runs` is not `true`",
+		Attributes:  getJobRunAttributes(),
+	}
+
+	jobAttributes["latest_successful_run"] = schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The most recent successful run of the job. Null if the job has never run successfully or if `include_latest_runs` is not `true`",
+		Attributes:  getJobRunAttributes(),
 	}
 
 	jobAttributes["deferring_job_id"] = schema.Int64Attribute{
@@ -239,7 +311,7 @@ func (j *jobDataSource) Schema(
 	}
 
 	resp.Schema = schema.Schema{
-		Description: "Get detailed information for a specific dbt Cloud job.",
+		Description: "Get detailed information for a specific dbt Cloud job, retrieved by ID or by project, environment and name.",
 		Attributes:  jobAttributes,
 	}
 }
@@ -312,6 +384,9 @@ var (
 		"custom_cron",
 		"interval_cron",
 	}
+	costOptimizationFeatures = []string{
+		"state_aware_orchestration",
+	}
 )
 
 func (j *jobResource) Schema(
@@ -466,9 +541,23 @@ func (j *jobResource) Schema(
 			"execute_steps": resource_schema.ListAttribute{
 				Required:    true,
 				ElementType: types.StringType,
-				Description: "List of commands to execute for the job",
+				Description: "List of commands to execute for the job. Each step must be a dbt command, e.g. `dbt build --select my_model`. Typos of known commands are rejected while unknown commands and flags only raise a warning, flags only available in dbt Fusion are expected when `dbt_version` is `latest-fusion` or not set",
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
+					helper.ExecuteStepsValidator{},
+				},
+			},
+			"env_var_overrides": resource_schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Map of environment variable names to the values they take for this job, overriding the project and environment values. When set, it manages the full set of overrides of the job: overrides not listed are deleted, so it should not be used together with `dbtcloud_environment_variable_job_override` for the same job. When not set, the overrides of the job are not managed by this resource. Secret environment variables, prefixed with `DBT_ENV_SECRET_`, can't be set here and should use `dbtcloud_environment_variable_job_override` with `secret_raw_value`",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^DBT_`),
+							"The environment variable name must start with DBT_",
+						),
+					),
 				},
 			},
 			"is_active": resource_schema.BoolAttribute{
@@ -580,6 +669,28 @@ func (j *jobResource) Schema(
 				Default:     stringdefault.StaticString("--select state:modified"),
 				Description: "The model selector for checking changes in the compare changes Advanced CI feature",
 			},
+			"force_node_selection": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the job should run all the selected nodes. Set it to `false` to use state-aware orchestration and only run the nodes with changes since the last run. When removed from the config, it is reset to `true`. Only supported for deploy jobs (not CI or merge jobs)",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cost_optimization_features": resource_schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The cost optimization features to enable for the job, e.g. `state_aware_orchestration`. Only supported for deploy jobs (not CI or merge jobs)",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(costOptimizationFeatures...),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"job_type": resource_schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -649,3 +760,242 @@ func (j *jobResource) Schema(
 		},
 	}
 }
+
+func (d *jobGraphDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the graph of dependencies between dbt Cloud jobs, based on the job completion triggers (job chaining) and the deferral settings of the jobs. This can be used to see how jobs are chained across projects and to detect cycles in the job completion triggers.",
+		Attributes: map[string]schema.Attribute{
+			"project_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the projects for which we want to retrieve the jobs. If not set, the jobs from all the projects of the account are retrieved",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of jobs in the graph, ordered by job ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the job",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the job",
+						},
+						"project_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the project of the job",
+						},
+						"environment_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the environment of the job",
+						},
+						"job_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of job (e.g. ci, merge, scheduled)",
+						},
+						"deferring_job_definition_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the job definition this job defers to",
+						},
+						"deferring_environment_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the environment this job defers to",
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of relationships between the jobs. The upstream job or environment can be outside of the projects retrieved",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of relationship, one of `job_completion_trigger`, `deferring_job` or `deferring_environment`",
+						},
+						"upstream_job_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the upstream job (for `job_completion_trigger` and `deferring_job`)",
+						},
+						"upstream_environment_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the upstream environment (for `deferring_environment`)",
+						},
+						"downstream_job_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the job triggered by, or deferring to, the upstream job or environment",
+						},
+					},
+				},
+			},
+			"roots": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the jobs that are not triggered by, and don't defer to, any other job of the graph",
+			},
+			"leaves": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the jobs that don't trigger any other job of the graph and that no other job of the graph defers to",
+			},
+			"cycles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of cycles found in the job completion triggers",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "The IDs of the jobs in the cycle, each job being triggered by the completion of the next one, and the last one by the first one",
+						},
+					},
+				},
+			},
+			"has_cycles": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether cycles were found in the job completion triggers",
+			},
+		},
+	}
+}
+
+func (r *jobCopyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: helper.DocString(
+			`Copy an existing job, with its commands, settings, schedule and triggers, into a new job, for example in another environment.
+
+			Only the fields set in the config override the values of the source job, and only those fields are tracked for drift.
+			When ~~~sync_with_source~~~ is set to true, the copy is updated whenever the source job changes.`,
+		),
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the job copy",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the job copy",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_job_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the job to copy",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project ID to create the job copy in. Defaults to the project of the source job. A job completion trigger is copied as is and keeps referencing the upstream job in its project",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Environment ID to create the job copy in",
+			},
+			"sync_with_source": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to keep the fields that are not overridden in sync with the source job. When false, the source job is only read when the copy is created",
+			},
+			"copied_settings_hash": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the settings copied from the source job (the ones not overridden), used to detect when the copy is no longer in sync with the source job",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Job name - overrides the name of the source job",
+			},
+			"description": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the job - overrides the description of the source job",
+			},
+			"execute_steps": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "List of commands to execute for the job - overrides the steps of the source job",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					helper.ExecuteStepsValidator{},
+				},
+			},
+			"dbt_version": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Version number of dbt to use in this job - overrides the version of the source job",
+			},
+			"num_threads": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of threads to use in the job - overrides the number of threads of the source job",
+			},
+			"target_name": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Target name for the dbt profile - overrides the target name of the source job",
+			},
+			"timeout_seconds": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds to allow the job to run before timing out - overrides the timeout of the source job",
+			},
+			"generate_docs": resource_schema.BoolAttribute{
+				Optional:    true,
+				Description: "Flag for whether the job should generate documentation - overrides the value of the source job",
+			},
+			"run_generate_sources": resource_schema.BoolAttribute{
+				Optional:    true,
+				Description: "Flag for whether the job should add a `dbt source freshness` step to the job - overrides the value of the source job",
+			},
+			"schedule_cron": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom cron expression for the schedule - overrides the schedule of the source job",
+			},
+			"triggers": resource_schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]resource_schema.Attribute{
+					"github_webhook": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs automatically on PR creation",
+					},
+					"git_provider_webhook": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs automatically on PR creation",
+					},
+					"schedule": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs on a schedule",
+					},
+					"on_merge": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs automatically once a PR is merged",
+					},
+				},
+				Description: "Flags for which types of triggers to use - overrides the triggers of the source job. Changing the type of job (CI, merge or other) is not supported by dbt Cloud",
+			},
+			"deferring_environment_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Environment identifier that this job defers to - overrides the deferral of the source job",
+			},
+		},
+	}
+}
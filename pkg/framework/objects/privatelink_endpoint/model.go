@@ -15,3 +15,15 @@ type PrivatelinkEndpointDataSourceModel struct {
 type PrivatelinkEndpointsDataSourceModel struct {
 	Endpoints []PrivatelinkEndpointDataSourceModel `tfsdk:"endpoints"`
 }
+
+type PrivatelinkEndpointResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	PrivatelinkEndpointType types.String `tfsdk:"type"`
+	ServiceName             types.String `tfsdk:"service_name"`
+	WaitForActive           types.Bool   `tfsdk:"wait_for_active"`
+	TimeoutMinutes          types.Int64  `tfsdk:"timeout_minutes"`
+	Status                  types.String `tfsdk:"status"`
+	PrivatelinkEndpointURL  types.String `tfsdk:"private_link_endpoint_url"`
+	CIDRRange               types.String `tfsdk:"cidr_range"`
+}
@@ -0,0 +1,209 @@
+package privatelink_endpoint
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pollInterval = 30 * time.Second
+
+var (
+	_ resource.Resource                = &privatelinkEndpointResource{}
+	_ resource.ResourceWithConfigure   = &privatelinkEndpointResource{}
+	_ resource.ResourceWithImportState = &privatelinkEndpointResource{}
+)
+
+func PrivatelinkEndpointResource() resource.Resource {
+	return &privatelinkEndpointResource{}
+}
+
+type privatelinkEndpointResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *privatelinkEndpointResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_privatelink_endpoint"
+}
+
+func (r *privatelinkEndpointResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+// setEndpointState sets the values returned by dbt Cloud, the service name is not returned and is kept from the config
+func setEndpointState(state *PrivatelinkEndpointResourceModel, endpoint *dbt_cloud.PrivatelinkEndpoint) {
+	state.ID = types.StringValue(endpoint.ID)
+	state.Name = types.StringValue(endpoint.Name)
+	state.PrivatelinkEndpointType = types.StringValue(endpoint.Type)
+	state.Status = types.StringValue(endpoint.Status)
+	state.PrivatelinkEndpointURL = types.StringValue(endpoint.PrivatelinkEndpointURL)
+	state.CIDRRange = types.StringValue(endpoint.CIDRRange)
+}
+
+func (r *privatelinkEndpointResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan PrivatelinkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := r.client.CreatePrivatelinkEndpoint(
+		plan.Name.ValueString(),
+		plan.PrivatelinkEndpointType.ValueString(),
+		plan.ServiceName.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the PrivateLink endpoint",
+			"Could not create the PrivateLink endpoint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.WaitForActive.ValueBool() {
+		activeEndpoint, err := r.client.WaitForPrivatelinkEndpoint(
+			ctx,
+			endpoint.ID,
+			pollInterval,
+			time.Duration(plan.TimeoutMinutes.ValueInt64())*time.Minute,
+		)
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			// the request is saved in the state so that it is not created again, the status is refreshed on the next plans
+			resp.Diagnostics.AddWarning("PrivateLink endpoint not active yet", err.Error())
+			endpoint = activeEndpoint
+		case err != nil:
+			// the endpoint exists and is saved in the state so that it gets deleted when recreated
+			resp.Diagnostics.AddError("Error waiting for the PrivateLink endpoint", err.Error())
+			if activeEndpoint != nil {
+				endpoint = activeEndpoint
+			}
+		default:
+			endpoint = activeEndpoint
+		}
+	}
+
+	setEndpointState(&plan, endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *privatelinkEndpointResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state PrivatelinkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := r.client.GetPrivatelinkEndpointByID(state.ID.ValueString())
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The PrivateLink endpoint was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading the PrivateLink endpoint",
+			"Could not read the PrivateLink endpoint "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	setEndpointState(&state, endpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the settings used when creating the endpoint, and the service name after an import.
+// All the other changes require a replacement.
+func (r *privatelinkEndpointResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state PrivatelinkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ServiceName = plan.ServiceName
+	state.WaitForActive = plan.WaitForActive
+	state.TimeoutMinutes = plan.TimeoutMinutes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *privatelinkEndpointResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state PrivatelinkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePrivatelinkEndpoint(state.ID.ValueString())
+	if err != nil && !strings.HasPrefix(err.Error(), "resource-not-found") {
+		resp.Diagnostics.AddError(
+			"Error deleting the PrivateLink endpoint",
+			"Could not delete the PrivateLink endpoint, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *privatelinkEndpointResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// the settings used when creating the endpoint are not returned by dbt Cloud
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_active"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout_minutes"), int64(60))...)
+}
+
+func (r *privatelinkEndpointResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
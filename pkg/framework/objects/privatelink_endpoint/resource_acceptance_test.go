@@ -0,0 +1,60 @@
+package privatelink_endpoint_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudPrivatelinkEndpointResource(t *testing.T) {
+
+	// creating an endpoint requests it from dbt Cloud, so we only test it explicitly with a service that can be connected to
+	serviceName := os.Getenv("DBT_ACCEPTANCE_TEST_PRIVATE_LINK_SERVICE_NAME")
+	if serviceName == "" {
+		t.Skip("Skipping acceptance tests as DBT_ACCEPTANCE_TEST_PRIVATE_LINK_SERVICE_NAME is not set")
+	}
+
+	endpointName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// we don't wait for the endpoint to be provisioned to keep the test short
+			{
+				Config: testAccDbtCloudPrivatelinkEndpointResourceConfig(endpointName, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_privatelink_endpoint.test", "id"),
+					resource.TestCheckResourceAttrSet("dbtcloud_privatelink_endpoint.test", "status"),
+					resource.TestCheckResourceAttr("dbtcloud_privatelink_endpoint.test", "name", endpointName),
+					resource.TestCheckResourceAttr("dbtcloud_privatelink_endpoint.test", "type", "snowflake"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_privatelink_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"service_name",
+					"wait_for_active",
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudPrivatelinkEndpointResourceConfig(endpointName string, serviceName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_privatelink_endpoint" "test" {
+  name            = "%s"
+  type            = "snowflake"
+  service_name    = "%s"
+  wait_for_active = false
+}
+`, endpointName, serviceName)
+}
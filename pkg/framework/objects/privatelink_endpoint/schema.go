@@ -3,10 +3,93 @@ package privatelink_endpoint
 import (
 	"context"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+var resourceSchema = resource_schema.Schema{
+	Description: helper.DocString(
+		`Request a PrivateLink endpoint from dbt Cloud to a service exposed in your cloud provider, e.g. an AWS VPC endpoint service or an Azure Private Link service.
+
+		The endpoint is provisioned by dbt Cloud after the request is created, and the resource waits until it is ~~~ACTIVE~~~ so that its ID can be used as ~~~private_link_endpoint_id~~~ in connections and repositories.
+		If it is not active before the timeout, a warning is raised and the resource keeps the current status, which is refreshed on the next plans.
+
+		Endpoints that were provisioned before can be managed by importing them, or read with the ~~~dbtcloud_privatelink_endpoint~~~ data source.`,
+	),
+	Attributes: map[string]resource_schema.Attribute{
+		"id": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The internal ID of the PrivateLink Endpoint",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "Given descriptive name for the PrivateLink Endpoint",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "Type of the PrivateLink Endpoint, e.g. `snowflake`, `databricks`, `redshift`, `postgres` or `vcs`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"service_name": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "The service to connect to, the name of the AWS VPC endpoint service (e.g. `com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0`) or the resource ID of the Azure Private Link service",
+			PlanModifiers: []planmodifier.String{
+				// the service name is not returned by dbt Cloud, so it is only set in the state after an import
+				stringplanmodifier.RequiresReplaceIf(
+					func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					},
+					"Changing the service name requires a new endpoint",
+					"Changing the service name requires a new endpoint",
+				),
+			},
+		},
+		"wait_for_active": resource_schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether to wait for the endpoint to be active when it is created - Defaults to `true`",
+		},
+		"timeout_minutes": resource_schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(60),
+			Description: "The number of minutes to wait for the endpoint to be active - Defaults to `60`",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"status": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The provisioning status of the PrivateLink Endpoint, e.g. `PENDING` or `ACTIVE`",
+		},
+		"private_link_endpoint_url": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "URL of the PrivateLink Endpoint, available once it is active",
+		},
+		"cidr_range": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "CIDR range of the PrivateLink Endpoint",
+		},
+	},
+}
+
 var datasourceSchema = datasource_schema.Schema{
 	Description: "Privatelink endpoint data source.",
 	Attributes: map[string]datasource_schema.Attribute{
@@ -74,7 +74,7 @@ func (d *projectDataSource) Read(
 		return
 	}
 
-	var project *dbt_cloud.Project
+	var project *dbt_cloud.ProjectConnectionRepository
 
 	// Determine if we're looking up by project_id or name
 	if !state.ID.IsNull() {
@@ -87,7 +87,7 @@ func (d *projectDataSource) Read(
 		}
 
 		var err error
-		project, err = d.client.GetProject(state.ID.String())
+		project, err = d.client.GetProjectWithRelated(state.ID.ValueInt64())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read DBT Cloud Project",
@@ -114,38 +114,68 @@ func (d *projectDataSource) Read(
 	}
 
 	// Map the project data to the state model
-	state.ID = types.Int64Value(int64(*project.ID))
+	state.ID = types.Int64Value(project.ID)
 	state.Name = types.StringValue(project.Name)
 	state.Description = types.StringValue(project.Description)
-	state.DbtProjectSubdirectory = helper.ConvertStringPointer(project.DbtProjectSubdirectory)
-	if project.ConnectionID != nil {
-		state.ProjectConnection = &ProjectConnection{}
-		state.ProjectConnection.ID = types.Int64Value(int64(*project.ConnectionID))
-	} else {
-		state.ProjectConnection = nil
+	state.SemanticLayerConfigID = types.Int64PointerValue(project.SemanticLayerConfigID)
+	state.DbtProjectSubdirectory = types.StringValue(project.DbtProjectSubdirectory)
+	state.CreatedAt = types.StringValue(project.CreatedAt)
+	state.UpdatedAt = types.StringValue(project.UpdatedAt)
+
+	state.ProjectConnection = nil
+	if project.Connection != nil {
+		state.ProjectConnection = &ProjectConnection{
+			ID:             types.Int64PointerValue(project.Connection.ID),
+			Name:           types.StringPointerValue(project.Connection.Name),
+			AdapterVersion: types.StringPointerValue(project.Connection.AdapterVersion),
+		}
+	} else if project.ConnectionID != 0 {
+		state.ProjectConnection = &ProjectConnection{
+			ID:             types.Int64Value(project.ConnectionID),
+			Name:           types.StringNull(),
+			AdapterVersion: types.StringNull(),
+		}
 	}
 
-	if project.RepositoryID != nil {
-		state.Repository = &ProjectRepository{}
-		state.Repository.ID = types.Int64Value(int64(*project.RepositoryID))
-	} else {
-		state.Repository = nil
+	state.Repository = nil
+	if project.Repository != nil {
+		state.Repository = &ProjectRepository{
+			ID: types.Int64PointerValue(
+				helper.IntPointerToInt64Pointer(project.Repository.ID),
+			),
+			RemoteUrl: types.StringValue(project.Repository.RemoteUrl),
+			PullRequestURLTemplate: types.StringValue(
+				project.Repository.PullRequestURLTemplate,
+			),
+		}
+	} else if project.RepositoryID != 0 {
+		state.Repository = &ProjectRepository{
+			ID:                     types.Int64Value(project.RepositoryID),
+			RemoteUrl:              types.StringNull(),
+			PullRequestURLTemplate: types.StringNull(),
+		}
 	}
 
-	if project.FreshnessJobId != nil {
-		state.FreshnessJobID = types.Int64Value(int64(*project.FreshnessJobId))
-	} else {
-		state.FreshnessJobID = types.Int64Null()
+	state.Environments = []ProjectEnvironment{}
+	for _, environment := range project.Environments {
+		if environment.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		state.Environments = append(state.Environments, ProjectEnvironment{
+			ID:             types.Int64PointerValue(helper.IntPointerToInt64Pointer(environment.ID)),
+			Name:           types.StringValue(environment.Name),
+			Type:           types.StringValue(environment.Type),
+			DeploymentType: types.StringPointerValue(environment.DeploymentType),
+			DbtVersion:     types.StringValue(environment.Dbt_Version),
+			CredentialsID:  types.Int64PointerValue(helper.IntPointerToInt64Pointer(environment.Credential_Id)),
+		})
 	}
 
-	if project.DocsJobId != nil {
-		state.DocsJobID = types.Int64Value(int64(*project.DocsJobId))
-	} else {
-		state.DocsJobID = types.Int64Null()
-	}
+	state.FreshnessJobID = types.Int64PointerValue(project.FreshnessJobID)
+	state.DocsJobID = types.Int64PointerValue(project.DocsJobID)
 
-	state.State = types.Int64Value(int64(project.State))
-	state.DbtProjectType = types.Int64Value(int64(project.DbtProjectType))
+	state.State = types.Int64Value(project.State)
+	state.DbtProjectType = types.Int64Value(project.DbtProjectType)
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -29,6 +29,22 @@ func TestAccDbtCloudProjectDataSource(t *testing.T) {
 						randomProjectName,
 					),
 					resource.TestCheckResourceAttrSet("data.dbtcloud_project.test_with_name", "state"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_project.test_with_name",
+						"dbt_project_subdirectory",
+						"project/subdirectory/path",
+					),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_project.test_with_name",
+						"environments.#",
+						"1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.dbtcloud_project.test_with_name",
+						"environments.0.id",
+						"dbtcloud_environment.test",
+						"environment_id",
+					),
 				),
 			},
 		},
@@ -43,12 +59,19 @@ func testAccProjectDataSourceConfig(projectName string) string {
 		type = 0
 	}
 
+	resource "dbtcloud_environment" "test" {
+		project_id = dbtcloud_project.test.id
+		name = "dev"
+		type = "development"
+	}
+
     data "dbtcloud_project" "test" {
 		id = dbtcloud_project.test.id
 	}
 
 	data "dbtcloud_project" "test_with_name" {
 		name = dbtcloud_project.test.name
+		depends_on = [dbtcloud_environment.test]
 	}
     `, projectName)
 }
@@ -21,19 +21,29 @@ type ProjectConnectionRepository struct {
 }
 
 type ProjectDataSourceModel struct {
-	ID                     types.Int64        `tfsdk:"id"`
-	Name                   types.String       `tfsdk:"name"`
-	Description            types.String       `tfsdk:"description"`
-	SemanticLayerConfigID  types.Int64        `tfsdk:"semantic_layer_config_id"`
-	DbtProjectSubdirectory types.String       `tfsdk:"dbt_project_subdirectory"`
-	DbtProjectType         types.Int64        `tfsdk:"type"`
-	CreatedAt              types.String       `tfsdk:"created_at"`
-	UpdatedAt              types.String       `tfsdk:"updated_at"`
-	ProjectConnection      *ProjectConnection `tfsdk:"project_connection"`
-	Repository             *ProjectRepository `tfsdk:"repository"`
-	FreshnessJobID         types.Int64        `tfsdk:"freshness_job_id"`
-	DocsJobID              types.Int64        `tfsdk:"docs_job_id"`
-	State                  types.Int64        `tfsdk:"state"`
+	ID                     types.Int64          `tfsdk:"id"`
+	Name                   types.String         `tfsdk:"name"`
+	Description            types.String         `tfsdk:"description"`
+	SemanticLayerConfigID  types.Int64          `tfsdk:"semantic_layer_config_id"`
+	DbtProjectSubdirectory types.String         `tfsdk:"dbt_project_subdirectory"`
+	DbtProjectType         types.Int64          `tfsdk:"type"`
+	CreatedAt              types.String         `tfsdk:"created_at"`
+	UpdatedAt              types.String         `tfsdk:"updated_at"`
+	ProjectConnection      *ProjectConnection   `tfsdk:"project_connection"`
+	Repository             *ProjectRepository   `tfsdk:"repository"`
+	Environments           []ProjectEnvironment `tfsdk:"environments"`
+	FreshnessJobID         types.Int64          `tfsdk:"freshness_job_id"`
+	DocsJobID              types.Int64          `tfsdk:"docs_job_id"`
+	State                  types.Int64          `tfsdk:"state"`
+}
+
+type ProjectEnvironment struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	DeploymentType types.String `tfsdk:"deployment_type"`
+	DbtVersion     types.String `tfsdk:"dbt_version"`
+	CredentialsID  types.Int64  `tfsdk:"credentials_id"`
 }
 
 type ProjectRepository struct {
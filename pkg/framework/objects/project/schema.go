@@ -97,7 +97,7 @@ var datasourceSchema = datasource_schema.Schema{
 }
 
 var singleDatasourceSchema = datasource_schema.Schema{
-	Description: "Retrieve a specific project from dbt Cloud.",
+	Description: "Retrieve a specific project from dbt Cloud, by ID or by name. The repository, connection and environments of the project are returned as well.",
 	Attributes: map[string]datasource_schema.Attribute{
 		"id": datasource_schema.Int64Attribute{
 			Optional:    true,
@@ -106,7 +106,7 @@ var singleDatasourceSchema = datasource_schema.Schema{
 		"name": datasource_schema.StringAttribute{
 			Computed:    true,
 			Optional:    true,
-			Description: "Project name",
+			Description: "Project name, used to look up the project when `id` is not set. The name is not case sensitive and must match only one project",
 		},
 		"description": datasource_schema.StringAttribute{
 			Computed:    true,
@@ -168,6 +168,38 @@ var singleDatasourceSchema = datasource_schema.Schema{
 				},
 			},
 		},
+		"environments": datasource_schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The environments of the project",
+			NestedObject: datasource_schema.NestedAttributeObject{
+				Attributes: map[string]datasource_schema.Attribute{
+					"id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "Environment ID",
+					},
+					"name": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Environment name",
+					},
+					"type": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of environment (development or deployment)",
+					},
+					"deployment_type": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of deployment environment (production, staging or empty)",
+					},
+					"dbt_version": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Version of dbt used in the environment",
+					},
+					"credentials_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "Credential ID of the environment",
+					},
+				},
+			},
+		},
 		"freshness_job_id": datasource_schema.Int64Attribute{
 			Computed:    true,
 			Description: "ID of Job for source freshness",
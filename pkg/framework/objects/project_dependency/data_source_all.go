@@ -0,0 +1,102 @@
+package project_dependency
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &projectDependenciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectDependenciesDataSource{}
+)
+
+func ProjectDependenciesDataSource() datasource.DataSource {
+	return &projectDependenciesDataSource{}
+}
+
+type projectDependenciesDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *projectDependenciesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_dependencies"
+}
+
+func (d *projectDependenciesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *projectDependenciesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state ProjectDependenciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDs := []int{}
+	if !state.ProjectID.IsNull() {
+		projectIDs = append(projectIDs, int(state.ProjectID.ValueInt64()))
+	} else {
+		projects, err := d.client.GetAllProjects("")
+		if err != nil {
+			resp.Diagnostics.AddError("Issue when retrieving projects", err.Error())
+			return
+		}
+		for _, project := range projects {
+			projectIDs = append(projectIDs, int(project.ID))
+		}
+	}
+
+	state.Dependencies = []ProjectDependencyDataSourceModel{}
+	for _, projectID := range projectIDs {
+		dependencies, err := d.client.GetProjectDependencies(projectID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when retrieving project dependencies",
+				fmt.Sprintf("Could not retrieve the dependencies of project %d: %s", projectID, err),
+			)
+			return
+		}
+
+		for _, dependency := range dependencies {
+			if dependency.State == dbt_cloud.STATE_DELETED {
+				continue
+			}
+			state.Dependencies = append(state.Dependencies, ProjectDependencyDataSourceModel{
+				ProjectID:         types.Int64Value(int64(dependency.ProjectID)),
+				UpstreamProjectID: types.Int64Value(int64(dependency.UpstreamProjectID)),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *projectDependenciesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
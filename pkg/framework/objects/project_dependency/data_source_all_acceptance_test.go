@@ -0,0 +1,41 @@
+package project_dependency_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudProjectDependenciesDataSource(t *testing.T) {
+
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	config := testAccDbtCloudProjectDependencyResourceConfig(projectName, true) + `
+data "dbtcloud_project_dependencies" "downstream" {
+  project_id = dbtcloud_project.downstream.id
+  depends_on = [dbtcloud_project_dependency.test]
+}
+`
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.dbtcloud_project_dependencies.downstream", "dependencies.#", "1"),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_project_dependencies.downstream",
+			"dependencies.0.upstream_project_id",
+			"dbtcloud_project.upstream",
+			"id",
+		),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
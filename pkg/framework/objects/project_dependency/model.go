@@ -0,0 +1,22 @@
+package project_dependency
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProjectDependencyResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ProjectID         types.Int64  `tfsdk:"project_id"`
+	UpstreamProjectID types.Int64  `tfsdk:"upstream_project_id"`
+	DependencyID      types.Int64  `tfsdk:"dependency_id"`
+}
+
+type ProjectDependencyDataSourceModel struct {
+	ProjectID         types.Int64 `tfsdk:"project_id"`
+	UpstreamProjectID types.Int64 `tfsdk:"upstream_project_id"`
+}
+
+type ProjectDependenciesDataSourceModel struct {
+	ProjectID    types.Int64                        `tfsdk:"project_id"`
+	Dependencies []ProjectDependencyDataSourceModel `tfsdk:"dependencies"`
+}
@@ -0,0 +1,277 @@
+package project_dependency
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &projectDependencyResource{}
+	_ resource.ResourceWithConfigure      = &projectDependencyResource{}
+	_ resource.ResourceWithImportState    = &projectDependencyResource{}
+	_ resource.ResourceWithModifyPlan     = &projectDependencyResource{}
+	_ resource.ResourceWithValidateConfig = &projectDependencyResource{}
+)
+
+func ProjectDependencyResource() resource.Resource {
+	return &projectDependencyResource{}
+}
+
+type projectDependencyResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *projectDependencyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_dependency"
+}
+
+func (r *projectDependencyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+func (r *projectDependencyResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ProjectDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ProjectID.IsUnknown() || config.UpstreamProjectID.IsUnknown() {
+		return
+	}
+
+	if config.ProjectID.Equal(config.UpstreamProjectID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("upstream_project_id"),
+			"Invalid project dependency",
+			"A project can't depend on itself, upstream_project_id must be different from project_id.",
+		)
+	}
+}
+
+// ModifyPlan validates the upstream project when the dependency is created,
+// so that a missing production environment is reported at plan time instead of failing the apply
+func (r *projectDependencyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// only new dependencies are validated, as all the changes require a replacement
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var upstreamProjectID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("upstream_project_id"), &upstreamProjectID)...)
+	if resp.Diagnostics.HasError() || upstreamProjectID.IsUnknown() || upstreamProjectID.IsNull() {
+		return
+	}
+
+	err := r.validateUpstreamProject(int(upstreamProjectID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("upstream_project_id"),
+			"Invalid upstream project",
+			err.Error(),
+		)
+	}
+}
+
+// hasProductionEnvironment returns true if one of the active environments is a production one,
+// which is required for the public models of an upstream project to be referenced
+func hasProductionEnvironment(environments []dbt_cloud.Environment) bool {
+	for _, environment := range environments {
+		if environment.State != dbt_cloud.STATE_DELETED &&
+			environment.DeploymentType != nil &&
+			*environment.DeploymentType == "production" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUpstreamProject checks that the upstream project exists and has a production environment
+func (r *projectDependencyResource) validateUpstreamProject(upstreamProjectID int) error {
+	_, err := r.client.GetProject(strconv.Itoa(upstreamProjectID))
+	if err != nil {
+		return fmt.Errorf("the upstream project %d could not be retrieved: %s", upstreamProjectID, err)
+	}
+
+	environments, err := r.client.GetAllEnvironments(upstreamProjectID)
+	if err != nil {
+		return fmt.Errorf("the environments of the upstream project %d could not be retrieved: %s", upstreamProjectID, err)
+	}
+
+	if !hasProductionEnvironment(environments) {
+		return fmt.Errorf(
+			"the upstream project %d doesn't have a production environment, "+
+				"one is required for its public models to be referenced by other projects",
+			upstreamProjectID,
+		)
+	}
+	return nil
+}
+
+func (r *projectDependencyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ProjectDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	upstreamProjectID := int(plan.UpstreamProjectID.ValueInt64())
+
+	dependency, err := r.client.CreateProjectDependency(projectID, upstreamProjectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the project dependency", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(
+		fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, upstreamProjectID),
+	)
+	plan.DependencyID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(dependency.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectDependencyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ProjectDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependency, err := r.client.GetProjectDependency(
+		int(state.ProjectID.ValueInt64()),
+		int(state.UpstreamProjectID.ValueInt64()),
+	)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The project dependency was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the project dependency", err.Error())
+		return
+	}
+
+	state.ProjectID = types.Int64Value(int64(dependency.ProjectID))
+	state.UpstreamProjectID = types.Int64Value(int64(dependency.UpstreamProjectID))
+	state.DependencyID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(dependency.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *projectDependencyResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// all the attributes require a replacement
+	resp.Diagnostics.AddError(
+		"Error updating the project dependency",
+		"Project dependencies don't support updates, all changes require a replacement",
+	)
+}
+
+func (r *projectDependencyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ProjectDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+
+	// the import only sets the project IDs, so until the imported resource is read the ID of the dependency
+	// is looked up in the dependencies of the project
+	dependencyID := int(state.DependencyID.ValueInt64())
+	if state.DependencyID.IsNull() || state.DependencyID.IsUnknown() {
+		dependency, err := r.client.GetProjectDependency(projectID, int(state.UpstreamProjectID.ValueInt64()))
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "resource-not-found") {
+				return
+			}
+			resp.Diagnostics.AddError("Error getting the project dependency", err.Error())
+			return
+		}
+		dependencyID = *dependency.ID
+	}
+
+	err := r.client.DeleteProjectDependency(projectID, dependencyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting the project dependency", err.Error())
+		return
+	}
+}
+
+func (r *projectDependencyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectID, upstreamProjectID, err := helper.SplitIDToInts(req.ID, "dbtcloud_project_dependency")
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing the project dependency", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), int64(projectID))...)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("upstream_project_id"), int64(upstreamProjectID))...,
+	)
+}
+
+func (r *projectDependencyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
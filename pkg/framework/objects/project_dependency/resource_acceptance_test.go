@@ -0,0 +1,89 @@
+package project_dependency_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudProjectDependencyResource(t *testing.T) {
+
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the upstream project doesn't have a production environment yet
+			{
+				Config:      testAccDbtCloudProjectDependencyResourceConfig(projectName, false),
+				ExpectError: regexp.MustCompile("doesn't have a production environment"),
+			},
+			{
+				Config: testAccDbtCloudProjectDependencyResourceConfig(projectName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_project_dependency.test",
+						"project_id",
+						"dbtcloud_project.downstream",
+						"id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_project_dependency.test",
+						"upstream_project_id",
+						"dbtcloud_project.upstream",
+						"id",
+					),
+					resource.TestCheckResourceAttrSet("dbtcloud_project_dependency.test", "id"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "dbtcloud_project_dependency.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDbtCloudProjectDependencyResourceConfig(projectName string, withProduction bool) string {
+	productionEnvironment := ""
+	if withProduction {
+		productionEnvironment = fmt.Sprintf(`
+resource "dbtcloud_environment" "upstream_prod" {
+  project_id      = dbtcloud_project.upstream.id
+  name            = "Production"
+  dbt_version     = "%s"
+  type            = "deployment"
+  deployment_type = "production"
+}
+`, acctest_config.DBT_CLOUD_VERSION)
+	}
+
+	dependsOn := ""
+	if withProduction {
+		dependsOn = "depends_on = [dbtcloud_environment.upstream_prod]"
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "upstream" {
+  name = "%s-upstream"
+}
+
+resource "dbtcloud_project" "downstream" {
+  name = "%s-downstream"
+}
+%s
+resource "dbtcloud_project_dependency" "test" {
+  project_id          = dbtcloud_project.downstream.id
+  upstream_project_id = dbtcloud_project.upstream.id
+  %s
+}
+`, projectName, projectName, productionEnvironment, dependsOn)
+}
@@ -0,0 +1,25 @@
+package project_dependency
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasProductionEnvironment(t *testing.T) {
+	production, staging := "production", "staging"
+
+	assert.False(t, hasProductionEnvironment(nil))
+	assert.False(t, hasProductionEnvironment([]dbt_cloud.Environment{
+		{Name: "Development", State: dbt_cloud.STATE_ACTIVE},
+		{Name: "Staging", DeploymentType: &staging, State: dbt_cloud.STATE_ACTIVE},
+	}))
+	assert.False(t, hasProductionEnvironment([]dbt_cloud.Environment{
+		{Name: "Production", DeploymentType: &production, State: dbt_cloud.STATE_DELETED},
+	}))
+	assert.True(t, hasProductionEnvironment([]dbt_cloud.Environment{
+		{Name: "Development", State: dbt_cloud.STATE_ACTIVE},
+		{Name: "Production", DeploymentType: &production, State: dbt_cloud.STATE_ACTIVE},
+	}))
+}
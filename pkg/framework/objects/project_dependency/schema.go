@@ -0,0 +1,71 @@
+package project_dependency
+
+import (
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var resourceSchema = schema.Schema{
+	Description: "Manages the dependency of a dbt Cloud project on an upstream project, to reference the public models of the upstream project with dbt Mesh. " +
+		"The upstream project needs to have a production environment, which is checked when planning the creation of the dependency.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the dependency, in the format `project_id:upstream_project_id`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "The ID of the project depending on the upstream project",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"upstream_project_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "The ID of the upstream project whose public models are referenced",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"dependency_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the dependency in dbt Cloud",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
+
+var datasourceSchema = datasource_schema.Schema{
+	Description: "Retrieve the dependencies between the projects of the account. " +
+		"When `project_id` is not set, the dependencies of all the projects are retrieved, which requires one API call per project.",
+	Attributes: map[string]datasource_schema.Attribute{
+		"project_id": datasource_schema.Int64Attribute{
+			Optional:    true,
+			Description: "The ID of the project to retrieve the upstream dependencies for [Optional]",
+		},
+		"dependencies": datasource_schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The list of dependencies, each one linking a project to an upstream project",
+			NestedObject: datasource_schema.NestedAttributeObject{
+				Attributes: map[string]datasource_schema.Attribute{
+					"project_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the project depending on the upstream project",
+					},
+					"upstream_project_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the upstream project",
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &repositoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoriesDataSource{}
+)
+
+func RepositoriesDataSource() datasource.DataSource {
+	return &repositoriesDataSource{}
+}
+
+type repositoriesDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *repositoriesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *repositoriesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = RepositoriesDataSourceSchema()
+}
+
+func (d *repositoriesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state RepositoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectIDs := []int{}
+	if !state.ProjectID.IsNull() {
+		projectIDs = append(projectIDs, int(state.ProjectID.ValueInt64()))
+	} else {
+		projects, err := d.client.GetAllProjects("")
+		if err != nil {
+			resp.Diagnostics.AddError("Issue when retrieving projects", err.Error())
+			return
+		}
+		for _, project := range projects {
+			projectIDs = append(projectIDs, int(project.ID))
+		}
+	}
+
+	allRepositories := []dbt_cloud.Repository{}
+	for _, projectID := range projectIDs {
+		repositories, err := d.client.GetProjectRepositories(projectID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when retrieving repositories",
+				fmt.Sprintf("Could not retrieve the repositories of project %d: %s", projectID, err),
+			)
+			return
+		}
+		allRepositories = append(allRepositories, repositories...)
+	}
+
+	filteredRepositories := filterRepositories(
+		allRepositories,
+		state.GitCloneStrategy.ValueString(),
+		state.RemoteURLContains.ValueString(),
+	)
+
+	state.Repositories = []RepositoriesDataSourceInfo{}
+	for _, repository := range filteredRepositories {
+		state.Repositories = append(state.Repositories, repositoryInfo(repository))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// filterRepositories removes the deleted repositories and the ones not matching the filters, empty filters match everything
+func filterRepositories(
+	repositories []dbt_cloud.Repository,
+	gitCloneStrategy string,
+	remoteURLContains string,
+) []dbt_cloud.Repository {
+	filtered := []dbt_cloud.Repository{}
+	for _, repository := range repositories {
+		if repository.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		if gitCloneStrategy != "" && repository.GitCloneStrategy != gitCloneStrategy {
+			continue
+		}
+		if remoteURLContains != "" &&
+			!strings.Contains(strings.ToLower(repository.RemoteUrl), strings.ToLower(remoteURLContains)) {
+			continue
+		}
+		filtered = append(filtered, repository)
+	}
+	return filtered
+}
+
+func repositoryInfo(repository dbt_cloud.Repository) RepositoriesDataSourceInfo {
+	info := RepositoriesDataSourceInfo{
+		ID: types.StringValue(
+			fmt.Sprintf("%d%s%d", repository.ProjectID, dbt_cloud.ID_DELIMITER, *repository.ID),
+		),
+		RepositoryID:                     types.Int64Value(int64(*repository.ID)),
+		ProjectID:                        types.Int64Value(int64(repository.ProjectID)),
+		IsActive:                         types.BoolValue(repository.State == dbt_cloud.STATE_ACTIVE),
+		RemoteURL:                        types.StringValue(repository.RemoteUrl),
+		GitCloneStrategy:                 types.StringValue(repository.GitCloneStrategy),
+		PrivateLinkEndpointID:            types.StringPointerValue(repository.PrivateLinkEndpointID),
+		AzureActiveDirectoryProjectID:    types.StringPointerValue(repository.AzureActiveDirectoryProjectID),
+		AzureActiveDirectoryRepositoryID: types.StringPointerValue(repository.AzureActiveDirectoryRepositoryID),
+		RepositoryCredentialsID:          types.Int64Null(),
+		GitlabProjectID:                  types.Int64Null(),
+		GithubInstallationID:             types.Int64Null(),
+		DeployKey:                        types.StringNull(),
+		PullRequestURLTemplate:           types.StringNull(),
+	}
+
+	if repository.RepositoryCredentialsID != nil {
+		info.RepositoryCredentialsID = types.Int64Value(int64(*repository.RepositoryCredentialsID))
+	}
+	if repository.GitlabProjectID != nil {
+		info.GitlabProjectID = types.Int64Value(int64(*repository.GitlabProjectID))
+	}
+	if repository.GithubInstallationID != nil {
+		info.GithubInstallationID = types.Int64Value(int64(*repository.GithubInstallationID))
+	}
+	if repository.DeployKey != nil {
+		info.DeployKey = types.StringValue(repository.DeployKey.PublicKey)
+	}
+	if repository.PullRequestURLTemplate != "" {
+		info.PullRequestURLTemplate = types.StringValue(repository.PullRequestURLTemplate)
+	}
+
+	return info
+}
+
+func (d *repositoriesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
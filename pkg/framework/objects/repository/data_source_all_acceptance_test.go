@@ -0,0 +1,67 @@
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudRepositoriesDataSource(t *testing.T) {
+	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	repoUrl := "git@github.com:dbt-labs/terraform-provider-dbtcloud.git"
+
+	config := fmt.Sprintf(`
+    resource "dbtcloud_project" "test_project" {
+        name = "%s"
+    }
+
+    resource "dbtcloud_repository" "test_repository" {
+        project_id = dbtcloud_project.test_project.id
+        remote_url = "%s"
+    }
+
+    data "dbtcloud_repositories" "test" {
+        project_id = dbtcloud_project.test_project.id
+        depends_on = [dbtcloud_repository.test_repository]
+    }
+
+    data "dbtcloud_repositories" "test_filtered" {
+        project_id          = dbtcloud_project.test_project.id
+        git_clone_strategy  = "deploy_key"
+        remote_url_contains = "DBT-LABS/terraform"
+        depends_on          = [dbtcloud_repository.test_repository]
+    }
+
+    data "dbtcloud_repositories" "test_no_match" {
+        project_id         = dbtcloud_project.test_project.id
+        git_clone_strategy = "github_app"
+        depends_on         = [dbtcloud_repository.test_repository]
+    }
+    `, randomProjectName, repoUrl)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.dbtcloud_repositories.test", "repositories.#", "1"),
+		resource.TestCheckResourceAttrPair(
+			"data.dbtcloud_repositories.test", "repositories.0.id",
+			"dbtcloud_repository.test_repository", "id",
+		),
+		resource.TestCheckResourceAttr("data.dbtcloud_repositories.test", "repositories.0.remote_url", repoUrl),
+		resource.TestCheckResourceAttr("data.dbtcloud_repositories.test", "repositories.0.git_clone_strategy", "deploy_key"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_repositories.test", "repositories.0.deploy_key"),
+		resource.TestCheckResourceAttr("data.dbtcloud_repositories.test_filtered", "repositories.#", "1"),
+		resource.TestCheckResourceAttr("data.dbtcloud_repositories.test_no_match", "repositories.#", "0"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
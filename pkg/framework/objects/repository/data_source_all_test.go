@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterRepositories(t *testing.T) {
+	githubAppID, deployKeyID, gitlabID, deletedID := 1, 2, 3, 4
+
+	repositories := []dbt_cloud.Repository{
+		{ID: &githubAppID, ProjectID: 10, RemoteUrl: "git://github.com/My-Org/analytics.git", GitCloneStrategy: "github_app", State: dbt_cloud.STATE_ACTIVE},
+		{ID: &deployKeyID, ProjectID: 10, RemoteUrl: "git@github.com:my-org/marketing.git", GitCloneStrategy: "deploy_key", State: dbt_cloud.STATE_ACTIVE},
+		{ID: &gitlabID, ProjectID: 20, RemoteUrl: "my-group/finance", GitCloneStrategy: "deploy_token", State: dbt_cloud.STATE_ACTIVE},
+		{ID: &deletedID, ProjectID: 20, RemoteUrl: "git@github.com:my-org/finance.git", GitCloneStrategy: "deploy_key", State: dbt_cloud.STATE_DELETED},
+	}
+
+	repositoryIDs := func(repositories []dbt_cloud.Repository) []int {
+		ids := []int{}
+		for _, repository := range repositories {
+			ids = append(ids, *repository.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []int{githubAppID, deployKeyID, gitlabID}, repositoryIDs(filterRepositories(repositories, "", "")))
+	assert.Equal(t, []int{deployKeyID}, repositoryIDs(filterRepositories(repositories, "deploy_key", "")))
+	assert.Equal(t, []int{githubAppID, deployKeyID}, repositoryIDs(filterRepositories(repositories, "", "MY-ORG")))
+	assert.Equal(t, []int{githubAppID}, repositoryIDs(filterRepositories(repositories, "github_app", "my-org")))
+	assert.Empty(t, filterRepositories(repositories, "azure_active_directory_app", ""))
+}
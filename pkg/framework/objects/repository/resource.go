@@ -12,9 +12,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &repositoryResource{}
-	_ resource.ResourceWithConfigure   = &repositoryResource{}
-	_ resource.ResourceWithImportState = &repositoryResource{}
+	_ resource.Resource                   = &repositoryResource{}
+	_ resource.ResourceWithConfigure      = &repositoryResource{}
+	_ resource.ResourceWithImportState    = &repositoryResource{}
+	_ resource.ResourceWithValidateConfig = &repositoryResource{}
 )
 
 func RepositoryResource() resource.Resource {
@@ -41,6 +42,34 @@ func (r *repositoryResource) Schema(
 	resp.Schema = ResourceSchema()
 }
 
+func (r *repositoryResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config RepositoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// git_clone_strategy defaults to deploy_key when it is not set
+	if config.RotationTrigger.IsNull() || config.GitCloneStrategy.IsNull() || config.GitCloneStrategy.IsUnknown() {
+		return
+	}
+
+	if config.GitCloneStrategy.ValueString() != "deploy_key" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_trigger"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf(
+				"rotation_trigger can only be set for repositories using the `deploy_key` clone strategy, got: %s",
+				config.GitCloneStrategy.ValueString(),
+			),
+		)
+	}
+}
+
 func (r *repositoryResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -435,6 +464,20 @@ func (r *repositoryResource) Update(
 	// This field doesn't affect API behavior but needs to be consistent for Terraform
 	state.FetchDeployKey = plan.FetchDeployKey
 
+	// a new value of rotation_trigger regenerates the deploy key, removing it doesn't
+	if !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		rotatedRepository, err := r.client.RotateRepositoryDeployKey(repositoryID, projectID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error rotating the deploy key of the repository",
+				err.Error(),
+			)
+			return
+		}
+		state.DeployKey = types.StringValue(rotatedRepository.DeployKey.PublicKey)
+	}
+	state.RotationTrigger = plan.RotationTrigger
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
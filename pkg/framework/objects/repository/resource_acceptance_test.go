@@ -77,6 +77,42 @@ func TestAccDbtCloudRepositoryResourceDeploy(t *testing.T) {
 		ImportStateVerifyIgnore: []string{"fetch_deploy_key"},
 	}
 
+	var deployKeyBeforeRotation string
+	var saveDeployKeyTestStep = resource.TestStep{
+		Config: testAccDbtCloudRepositoryResourceGithubConfig(repoUrlGithub, projectName),
+		Check: func(s *terraform.State) error {
+			deployKeyBeforeRotation = s.RootModule().Resources["dbtcloud_repository.test_repository_github_app"].Primary.Attributes["deploy_key"]
+			return nil
+		},
+	}
+	var rotateDeployKeyTestStep = resource.TestStep{
+		// ROTATE the deploy key in place
+		Config: strings.Replace(
+			testAccDbtCloudRepositoryResourceGithubConfig(repoUrlGithub, projectName),
+			`git_clone_strategy = "deploy_key"`,
+			`git_clone_strategy = "deploy_key"
+  rotation_trigger = "2026-10-18"`,
+			1,
+		),
+		Check: resource.ComposeTestCheckFunc(
+			testAccCheckDbtCloudRepositoryExists(
+				"dbtcloud_repository.test_repository_github_app",
+			),
+			resource.TestCheckResourceAttr(
+				"dbtcloud_repository.test_repository_github_app",
+				"rotation_trigger",
+				"2026-10-18",
+			),
+			func(s *terraform.State) error {
+				deployKey := s.RootModule().Resources["dbtcloud_repository.test_repository_github_app"].Primary.Attributes["deploy_key"]
+				if deployKey == "" || deployKey == deployKeyBeforeRotation {
+					return fmt.Errorf("expected the deploy key to be rotated, got the same key %q", deployKey)
+				}
+				return nil
+			},
+		),
+	}
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
@@ -84,6 +120,8 @@ func TestAccDbtCloudRepositoryResourceDeploy(t *testing.T) {
 		Steps: []resource.TestStep{
 			createByDeployKeyTestStep,
 			importDeployTestStep,
+			saveDeployKeyTestStep,
+			rotateDeployKeyTestStep,
 		},
 	})
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func ResourceSchema() resource_schema.Schema {
@@ -118,6 +120,11 @@ func ResourceSchema() resource_schema.Schema {
 				Computed:    true,
 				Description: "Public key generated by dbt when using `deploy_key` clone strategy",
 			},
+			"rotation_trigger": resource_schema.StringAttribute{
+				Optional: true,
+				Description: "Arbitrary value that regenerates the deploy key of the repository in place when it changes, e.g. a date or a `time_rotating` ID - (for the `deploy_key` strategy only). " +
+					"The new public key is returned in `deploy_key` in the same apply so that it can be added to the git provider.",
+			},
 			"pull_request_url_template": resource_schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -200,3 +207,90 @@ func DataSourceSchema() datasource_schema.Schema {
 		},
 	}
 }
+
+func RepositoriesDataSourceSchema() datasource_schema.Schema {
+	return datasource_schema.Schema{
+		Description: "Retrieve all the repositories of the account, or of a given project, with optional filtering. This can be used to audit the Git integrations used across projects.",
+		Attributes: map[string]datasource_schema.Attribute{
+			"project_id": datasource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Return only the repositories of this project. When not set, the repositories of all the projects are returned",
+			},
+			"git_clone_strategy": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only the repositories using this Git clone strategy. Can be `deploy_key`, `github_app`, `deploy_token` or `azure_active_directory_app`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("deploy_key", "github_app", "deploy_token", "azure_active_directory_app"),
+				},
+			},
+			"remote_url_contains": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only the repositories whose remote URL contains this value (case insensitive)",
+			},
+			"repositories": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of repositories",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the repository in the format `project_id:repository_id`, as used by the `dbtcloud_repository` resource",
+						},
+						"repository_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID for the repository",
+						},
+						"project_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "Project ID the repository belongs to",
+						},
+						"is_active": datasource_schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the repository is active",
+						},
+						"remote_url": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Git URL for the repository or <Group>/<Project> for Gitlab",
+						},
+						"git_clone_strategy": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Git clone strategy for the repository",
+						},
+						"repository_credentials_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "Credentials ID for the repository (From the repository side not the dbt Cloud ID)",
+						},
+						"gitlab_project_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "Identifier for the Gitlab project",
+						},
+						"github_installation_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "Identifier for the GitHub installation",
+						},
+						"private_link_endpoint_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier for the PrivateLink endpoint.",
+						},
+						"azure_active_directory_project_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Azure Dev Ops project ID",
+						},
+						"azure_active_directory_repository_id": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The Azure Dev Ops repository ID",
+						},
+						"deploy_key": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key generated by dbt when using `deploy_key` clone strategy",
+						},
+						"pull_request_url_template": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The pull request URL template to be used when opening a pull request from within dbt Cloud's IDE",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package snowflake_credential
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errEncryptedPrivateKey is returned when the public key can't be derived because the private key is encrypted
+var errEncryptedPrivateKey = errors.New("the private key is encrypted")
+
+// snowflakePublicKey returns the public key of a PEM encoded RSA private key, in the format used in the RSA_PUBLIC_KEY
+// and RSA_PUBLIC_KEY_2 properties of Snowflake users, and its fingerprint, as shown in RSA_PUBLIC_KEY_FP and RSA_PUBLIC_KEY_2_FP
+func snowflakePublicKey(privateKeyPEM string) (publicKey string, fingerprint string, err error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(privateKeyPEM)))
+	if block == nil {
+		return "", "", errors.New("the private key is not PEM encoded")
+	}
+
+	var privateKey any
+	switch {
+	case block.Type == "ENCRYPTED PRIVATE KEY" || block.Headers["Proc-Type"] != "":
+		return "", "", errEncryptedPrivateKey
+	case block.Type == "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case block.Type == "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return "", "", fmt.Errorf("unexpected PEM block %q, expected a PRIVATE KEY", block.Type)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse the private key: %w", err)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return "", "", errors.New("could not get the public key of the private key")
+	}
+	if _, ok := signer.Public().(*rsa.PublicKey); !ok {
+		return "", "", errors.New("Snowflake only supports RSA keys for key pair authentication")
+	}
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return "", "", err
+	}
+
+	hash := sha256.Sum256(publicKeyDER)
+	return base64.StdEncoding.EncodeToString(publicKeyDER),
+		"SHA256:" + base64.StdEncoding.EncodeToString(hash[:]),
+		nil
+}
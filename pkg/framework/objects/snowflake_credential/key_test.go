@@ -0,0 +1,78 @@
+package snowflake_credential
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflakePublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	assert.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	assert.NoError(t, err)
+	hash := sha256.Sum256(publicKeyDER)
+	expectedPublicKey := base64.StdEncoding.EncodeToString(publicKeyDER)
+	expectedFingerprint := "SHA256:" + base64.StdEncoding.EncodeToString(hash[:])
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ecPKCS8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		privateKey string
+		wantErr    bool
+		encrypted  bool
+	}{
+		{
+			name:       "PKCS8",
+			privateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+		},
+		{
+			name:       "PKCS1 with surrounding whitespace",
+			privateKey: "\n" + string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})) + "\n",
+		},
+		{
+			name:       "encrypted PKCS8",
+			privateKey: string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte("encrypted")})),
+			wantErr:    true,
+			encrypted:  true,
+		},
+		{
+			name:       "not PEM",
+			privateKey: "not a key",
+			wantErr:    true,
+		},
+		{
+			name:       "not RSA",
+			privateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecPKCS8})),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publicKey, fingerprint, err := snowflakePublicKey(tt.privateKey)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			assert.Equal(t, tt.encrypted, errors.Is(err, errEncryptedPrivateKey))
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, expectedPublicKey, publicKey)
+			assert.Equal(t, expectedFingerprint, fingerprint)
+		})
+	}
+}
@@ -34,3 +34,35 @@ type SnowflakeCredentialResourceModel struct {
 	NumThreads              types.Int64  `tfsdk:"num_threads"`
 	SemanticLayerCredential types.Bool   `tfsdk:"semantic_layer_credential"`
 }
+
+// SnowflakeCredentialKeyRotationResourceModel is the model for the key rotation resource
+type SnowflakeCredentialKeyRotationResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ProjectID                  types.Int64  `tfsdk:"project_id"`
+	CredentialID               types.Int64  `tfsdk:"credential_id"`
+	ActiveKeySlot              types.Int64  `tfsdk:"active_key_slot"`
+	PrivateKey1                types.String `tfsdk:"private_key_1"`
+	PrivateKeyPassphrase1      types.String `tfsdk:"private_key_passphrase_1"`
+	PublicKey1                 types.String `tfsdk:"public_key_1"`
+	PublicKeyFingerprint1      types.String `tfsdk:"public_key_fingerprint_1"`
+	PrivateKey2                types.String `tfsdk:"private_key_2"`
+	PrivateKeyPassphrase2      types.String `tfsdk:"private_key_passphrase_2"`
+	PublicKey2                 types.String `tfsdk:"public_key_2"`
+	PublicKeyFingerprint2      types.String `tfsdk:"public_key_fingerprint_2"`
+	ActivePublicKeyFingerprint types.String `tfsdk:"active_public_key_fingerprint"`
+}
+
+// keySlot gives access to the attributes of one of the 2 key slots of the model
+type keySlot struct {
+	PrivateKey           *types.String
+	PrivateKeyPassphrase *types.String
+	PublicKey            *types.String
+	PublicKeyFingerprint *types.String
+}
+
+func (m *SnowflakeCredentialKeyRotationResourceModel) keySlots() map[int64]keySlot {
+	return map[int64]keySlot{
+		1: {&m.PrivateKey1, &m.PrivateKeyPassphrase1, &m.PublicKey1, &m.PublicKeyFingerprint1},
+		2: {&m.PrivateKey2, &m.PrivateKeyPassphrase2, &m.PublicKey2, &m.PublicKeyFingerprint2},
+	}
+}
@@ -0,0 +1,309 @@
+package snowflake_credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &snowflakeCredentialKeyRotationResource{}
+	_ resource.ResourceWithConfigure      = &snowflakeCredentialKeyRotationResource{}
+	_ resource.ResourceWithValidateConfig = &snowflakeCredentialKeyRotationResource{}
+	_ resource.ResourceWithModifyPlan     = &snowflakeCredentialKeyRotationResource{}
+)
+
+// keySlotNumbers are the slots in the order they are checked
+var keySlotNumbers = []int64{1, 2}
+
+func SnowflakeCredentialKeyRotationResource() resource.Resource {
+	return &snowflakeCredentialKeyRotationResource{}
+}
+
+type snowflakeCredentialKeyRotationResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_credential_key_rotation"
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = SnowflakeCredentialKeyRotationResourceSchema
+}
+
+func (r *snowflakeCredentialKeyRotationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config SnowflakeCredentialKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ActiveKeySlot.IsNull() || config.ActiveKeySlot.IsUnknown() {
+		return
+	}
+
+	activeSlot := config.ActiveKeySlot.ValueInt64()
+	activeKeys, ok := config.keySlots()[activeSlot]
+	if ok && activeKeys.PrivateKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(fmt.Sprintf("private_key_%d", activeSlot)),
+			"Missing private key",
+			fmt.Sprintf("The private key of the active slot %d must be set", activeSlot),
+		)
+	}
+}
+
+// setPublicKeys derives the public keys of the slots from their private keys, they are unknown until the private keys are known
+func setPublicKeys(model *SnowflakeCredentialKeyRotationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keySlots := model.keySlots()
+	for _, slot := range keySlotNumbers {
+		keys := keySlots[slot]
+		switch {
+		case keys.PrivateKey.IsNull():
+			*keys.PublicKey = types.StringNull()
+			*keys.PublicKeyFingerprint = types.StringNull()
+		case keys.PrivateKey.IsUnknown():
+			*keys.PublicKey = types.StringUnknown()
+			*keys.PublicKeyFingerprint = types.StringUnknown()
+		default:
+			publicKey, fingerprint, err := snowflakePublicKey(keys.PrivateKey.ValueString())
+			switch {
+			case errors.Is(err, errEncryptedPrivateKey):
+				// the public key of encrypted private keys needs to be provided to Snowflake by the user
+				*keys.PublicKey = types.StringNull()
+				*keys.PublicKeyFingerprint = types.StringNull()
+			case err != nil:
+				diags.AddAttributeError(
+					path.Root(fmt.Sprintf("private_key_%d", slot)),
+					"Invalid private key",
+					fmt.Sprintf("The private key of the slot %d can't be used for Snowflake key pair authentication: %s", slot, err),
+				)
+			default:
+				*keys.PublicKey = types.StringValue(publicKey)
+				*keys.PublicKeyFingerprint = types.StringValue(fingerprint)
+			}
+		}
+	}
+
+	if model.ActiveKeySlot.IsUnknown() {
+		model.ActivePublicKeyFingerprint = types.StringUnknown()
+	} else if activeKeys, ok := keySlots[model.ActiveKeySlot.ValueInt64()]; ok {
+		model.ActivePublicKeyFingerprint = *activeKeys.PublicKeyFingerprint
+	}
+
+	return diags
+}
+
+// activeKeyChanged returns true when the private key used by the credential is different in the plan and the state
+func activeKeyChanged(plan, state SnowflakeCredentialKeyRotationResourceModel) bool {
+	if !plan.ActiveKeySlot.Equal(state.ActiveKeySlot) {
+		return true
+	}
+	planKeys := plan.keySlots()[plan.ActiveKeySlot.ValueInt64()]
+	stateKeys := state.keySlots()[state.ActiveKeySlot.ValueInt64()]
+	return !planKeys.PrivateKey.Equal(*stateKeys.PrivateKey) ||
+		!planKeys.PrivateKeyPassphrase.Equal(*stateKeys.PrivateKeyPassphrase)
+}
+
+func (r *snowflakeCredentialKeyRotationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SnowflakeCredentialKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPublicKeys(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state SnowflakeCredentialKeyRotationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		activeSlot := plan.ActiveKeySlot.ValueInt64()
+		if plan.ActiveKeySlot.Equal(state.ActiveKeySlot) && activeKeyChanged(plan, state) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(fmt.Sprintf("private_key_%d", activeSlot)),
+				"Active private key replaced in place",
+				fmt.Sprintf(
+					"The private key of the active slot %d is changed, the credential is updated with it straight away. "+
+						"Jobs will fail until its public key is set in Snowflake, stage the new key in the other slot and switch "+
+						"active_key_slot to rotate the key without interruption.",
+					activeSlot,
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// setCredentialKey sets the private key of the active slot in the credential
+func (r *snowflakeCredentialKeyRotationResource) setCredentialKey(
+	plan SnowflakeCredentialKeyRotationResourceModel,
+) error {
+	projectID := int(plan.ProjectID.ValueInt64())
+	credentialID := int(plan.CredentialID.ValueInt64())
+
+	credential, err := r.client.GetSnowflakeCredential(projectID, credentialID)
+	if err != nil {
+		return err
+	}
+	if credential.Auth_Type != "keypair" {
+		return fmt.Errorf(
+			"the credential %d must use the keypair auth type for its key to be rotated, got: %s",
+			credentialID,
+			credential.Auth_Type,
+		)
+	}
+
+	activeKeys := plan.keySlots()[plan.ActiveKeySlot.ValueInt64()]
+	credential.PrivateKey = activeKeys.PrivateKey.ValueString()
+	credential.PrivateKeyPassphrase = activeKeys.PrivateKeyPassphrase.ValueString()
+
+	_, err = r.client.UpdateSnowflakeCredential(projectID, credentialID, *credential)
+	return err
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SnowflakeCredentialKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(setPublicKeys(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setCredentialKey(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting the private key of the Snowflake credential",
+			"Could not set the private key of the active slot in the credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(
+		fmt.Sprintf("%d%s%d", plan.ProjectID.ValueInt64(), dbt_cloud.ID_DELIMITER, plan.CredentialID.ValueInt64()),
+	)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SnowflakeCredentialKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the private key is never returned, we only check that the credential still exists
+	_, err := r.client.GetSnowflakeCredential(int(state.ProjectID.ValueInt64()), int(state.CredentialID.ValueInt64()))
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The Snowflake credential was not found and the key rotation has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading the Snowflake credential",
+			"Could not read the Snowflake credential "+strconv.FormatInt(state.CredentialID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state SnowflakeCredentialKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(setPublicKeys(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// staging a key in the other slot doesn't change the credential
+	if activeKeyChanged(plan, state) {
+		err := r.setCredentialKey(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting the private key of the Snowflake credential",
+				"Could not set the private key of the active slot in the credential, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, the credential keeps using the private key of the active slot
+func (r *snowflakeCredentialKeyRotationResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (r *snowflakeCredentialKeyRotationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
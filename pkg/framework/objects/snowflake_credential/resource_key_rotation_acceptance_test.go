@@ -0,0 +1,125 @@
+package snowflake_credential_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func generatePrivateKey(t *testing.T) string {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+}
+
+func TestAccDbtCloudSnowflakeCredentialKeyRotationResource(t *testing.T) {
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	privateKey1 := generatePrivateKey(t)
+	privateKey2 := generatePrivateKey(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudSnowflakeCredentialDestroy,
+		Steps: []resource.TestStep{
+			// the slot 1 is used by the credential
+			{
+				Config: testAccDbtCloudSnowflakeCredentialKeyRotationResourceConfig(projectName, privateKey1, "", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_snowflake_credential_key_rotation.test", "public_key_1"),
+					resource.TestCheckNoResourceAttr("dbtcloud_snowflake_credential_key_rotation.test", "public_key_2"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"active_public_key_fingerprint",
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"public_key_fingerprint_1",
+					),
+				),
+			},
+			// a new key is staged in the slot 2, the credential still uses the slot 1
+			{
+				Config: testAccDbtCloudSnowflakeCredentialKeyRotationResourceConfig(projectName, privateKey1, privateKey2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_snowflake_credential_key_rotation.test", "public_key_2"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"active_public_key_fingerprint",
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"public_key_fingerprint_1",
+					),
+				),
+			},
+			// the credential is switched to the slot 2
+			{
+				Config: testAccDbtCloudSnowflakeCredentialKeyRotationResourceConfig(projectName, privateKey1, privateKey2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_snowflake_credential_key_rotation.test", "active_key_slot", "2"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"active_public_key_fingerprint",
+						"dbtcloud_snowflake_credential_key_rotation.test",
+						"public_key_fingerprint_2",
+					),
+				),
+			},
+			// the previous key is removed
+			{
+				Config: testAccDbtCloudSnowflakeCredentialKeyRotationResourceConfig(projectName, "", privateKey2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dbtcloud_snowflake_credential_key_rotation.test", "public_key_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudSnowflakeCredentialKeyRotationResourceConfig(
+	projectName string,
+	privateKey1 string,
+	privateKey2 string,
+	activeKeySlot int,
+) string {
+	privateKeysConfig := ""
+	if privateKey1 != "" {
+		privateKeysConfig += fmt.Sprintf("private_key_1 = %q\n", privateKey1)
+	}
+	if privateKey2 != "" {
+		privateKeysConfig += fmt.Sprintf("private_key_2 = %q\n", privateKey2)
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_snowflake_credential" "test_credential" {
+  is_active   = true
+  project_id  = dbtcloud_project.test_project.id
+  auth_type   = "keypair"
+  schema      = "SCHEMA"
+  user        = "USER"
+  num_threads = 3
+}
+
+resource "dbtcloud_snowflake_credential_key_rotation" "test" {
+  project_id      = dbtcloud_project.test_project.id
+  credential_id   = dbtcloud_snowflake_credential.test_credential.credential_id
+  active_key_slot = %d
+  %s
+}
+`, projectName, activeKeySlot, privateKeysConfig)
+}
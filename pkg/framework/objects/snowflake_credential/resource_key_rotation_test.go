@@ -0,0 +1,67 @@
+package snowflake_credential
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPublicKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	assert.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	publicKey, fingerprint, err := snowflakePublicKey(privateKey)
+	assert.NoError(t, err)
+
+	// the new key is staged in the slot 2 while the slot 1 is still active
+	model := SnowflakeCredentialKeyRotationResourceModel{
+		ActiveKeySlot: types.Int64Value(1),
+		PrivateKey1:   types.StringUnknown(),
+		PrivateKey2:   types.StringValue(privateKey),
+	}
+	diags := setPublicKeys(&model)
+	assert.False(t, diags.HasError())
+	assert.True(t, model.PublicKey1.IsUnknown())
+	assert.True(t, model.ActivePublicKeyFingerprint.IsUnknown())
+	assert.Equal(t, publicKey, model.PublicKey2.ValueString())
+	assert.Equal(t, fingerprint, model.PublicKeyFingerprint2.ValueString())
+
+	// the slot 2 is then activated and the slot 1 emptied
+	model.ActiveKeySlot = types.Int64Value(2)
+	model.PrivateKey1 = types.StringNull()
+	diags = setPublicKeys(&model)
+	assert.False(t, diags.HasError())
+	assert.True(t, model.PublicKey1.IsNull())
+	assert.Equal(t, fingerprint, model.ActivePublicKeyFingerprint.ValueString())
+
+	model.PrivateKey1 = types.StringValue("not a key")
+	diags = setPublicKeys(&model)
+	assert.True(t, diags.HasError())
+}
+
+func TestActiveKeyChanged(t *testing.T) {
+	state := SnowflakeCredentialKeyRotationResourceModel{
+		ActiveKeySlot: types.Int64Value(1),
+		PrivateKey1:   types.StringValue("key1"),
+		PrivateKey2:   types.StringNull(),
+	}
+
+	staged := state
+	staged.PrivateKey2 = types.StringValue("key2")
+	assert.False(t, activeKeyChanged(staged, state))
+
+	switched := staged
+	switched.ActiveKeySlot = types.Int64Value(2)
+	assert.True(t, activeKeyChanged(switched, staged))
+
+	replaced := state
+	replaced.PrivateKey1 = types.StringValue("new key1")
+	assert.True(t, activeKeyChanged(replaced, state))
+}
@@ -1,7 +1,11 @@
 package snowflake_credential
 
 import (
+	"fmt"
+
 	snowflake_credential "github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/snowflake_credential/validators"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
 )
 
 var datasourceSchema = datasource_schema.Schema{
@@ -157,3 +162,79 @@ var SnowflakeCredentialResourceSchema = resource_schema.Schema{
 		},
 	},
 }
+
+// keySlotAttributes returns the attributes of a key slot, the number of the slot is used as suffix
+func keySlotAttributes(slot string, snowflakeProperty string) map[string]resource_schema.Attribute {
+	return map[string]resource_schema.Attribute{
+		"private_key_" + slot: resource_schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: fmt.Sprintf("The PEM encoded RSA private key of the slot %s, whose public key is set in `%s` in Snowflake", slot, snowflakeProperty),
+		},
+		"private_key_passphrase_" + slot: resource_schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: fmt.Sprintf("The passphrase of the private key of the slot %s, when it is encrypted", slot),
+		},
+		"public_key_" + slot: resource_schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The public key of the slot %s, to set in `%s` in Snowflake. It is null when the private key is encrypted", slot, snowflakeProperty),
+		},
+		"public_key_fingerprint_" + slot: resource_schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The fingerprint of the public key of the slot %s, as shown in `%s_FP` by `DESC USER` in Snowflake", slot, snowflakeProperty),
+		},
+	}
+}
+
+var SnowflakeCredentialKeyRotationResourceSchema = resource_schema.Schema{
+	Description: helper.DocString(
+		`Rotate the private key of a Snowflake credential using key pair authentication without breaking the jobs running during the rotation.
+
+		Snowflake users have 2 public key slots, ~~~RSA_PUBLIC_KEY~~~ and ~~~RSA_PUBLIC_KEY_2~~~, and accept both keys. This resource stores a private key for each slot and sets the one of ~~~active_key_slot~~~ in the credential:
+		1. stage a new private key in the slot not in use and add its public key to the matching slot in Snowflake
+		2. once Snowflake accepts the new key, switch ~~~active_key_slot~~~ to it, the credential is then updated
+		3. remove the previous public key from Snowflake, its slot is used for the next rotation
+
+		The ~~~private_key~~~ and ~~~private_key_passphrase~~~ of the ~~~dbtcloud_snowflake_credential~~~ resource must not be set when using this resource, otherwise the key would be changed back when the credential is updated.
+		Deleting this resource keeps the current key in the credential.`,
+	),
+	Attributes: lo.Assign(
+		map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource. Contains the project ID and the credential ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID of the credential",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"credential_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the Snowflake credential, which must use the `keypair` auth type",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"active_key_slot": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "The slot of the private key used by the credential, `1` or `2`. Switch it only once the public key of the slot is set in Snowflake",
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2),
+				},
+			},
+			"active_public_key_fingerprint": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The fingerprint of the public key of the active slot, to check which key Snowflake is expected to accept",
+			},
+		},
+		keySlotAttributes("1", "RSA_PUBLIC_KEY"),
+		keySlotAttributes("2", "RSA_PUBLIC_KEY_2"),
+	),
+}
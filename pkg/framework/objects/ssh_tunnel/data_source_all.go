@@ -0,0 +1,80 @@
+package ssh_tunnel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &sshTunnelDataSourceAll{}
+	_ datasource.DataSourceWithConfigure = &sshTunnelDataSourceAll{}
+)
+
+func SSHTunnelDataSourceAll() datasource.DataSource {
+	return &sshTunnelDataSourceAll{}
+}
+
+type sshTunnelDataSourceAll struct {
+	client *dbt_cloud.Client
+}
+
+func (d *sshTunnelDataSourceAll) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sshTunnelDataSourceAll) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_tunnels"
+}
+
+func (d *sshTunnelDataSourceAll) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceSchemaAll
+}
+
+func (d *sshTunnelDataSourceAll) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SSHTunnelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnels, err := d.client.GetAllSSHTunnels()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading the SSH tunnels",
+			"Could not read the SSH tunnels: "+err.Error(),
+		)
+		return
+	}
+
+	allSSHTunnels := []SSHTunnelDataSourceModel{}
+	for _, sshTunnel := range sshTunnels {
+		allSSHTunnels = append(allSSHTunnels, SSHTunnelDataSourceModel{
+			ID:           types.Int64PointerValue(sshTunnel.ID),
+			ConnectionID: types.Int64Value(sshTunnel.ConnectionID),
+			Username:     types.StringValue(sshTunnel.Username),
+			Port:         types.Int64Value(sshTunnel.Port),
+			HostName:     types.StringValue(sshTunnel.HostName),
+			PublicKey:    types.StringValue(sshTunnel.PublicKey),
+		})
+	}
+	state.SSHTunnels = allSSHTunnels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
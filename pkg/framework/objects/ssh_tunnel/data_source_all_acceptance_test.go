@@ -0,0 +1,100 @@
+package ssh_tunnel_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSSHTunnelsDataSource_Basic(t *testing.T) {
+	originalTFAcc := os.Getenv("TF_ACC")
+	os.Setenv("TF_ACC", "1")
+	defer func() {
+		if originalTFAcc == "" {
+			os.Unsetenv("TF_ACC")
+		} else {
+			os.Setenv("TF_ACC", originalTFAcc)
+		}
+	}()
+
+	mockSSHTunnels := []map[string]interface{}{
+		{
+			"id":            1001,
+			"account_id":    123,
+			"connection_id": 10,
+			"username":      "dbt",
+			"port":          22,
+			"hostname":      "bastion-prod.example.com",
+			"public_key":    "ssh-rsa AAAAprod dbt-cloud",
+			"state":         1,
+		},
+		{
+			"id":            1002,
+			"account_id":    123,
+			"connection_id": 20,
+			"username":      "dbt_staging",
+			"port":          2222,
+			"hostname":      "bastion-staging.example.com",
+			"public_key":    "ssh-rsa AAAAstaging dbt-cloud",
+			"state":         1,
+		},
+	}
+
+	handlers := map[string]testhelpers.MockEndpointHandler{
+		"GET /v2/accounts/123/encryptions/": func(r *http.Request) (int, interface{}, error) {
+			response := map[string]interface{}{
+				"data": mockSSHTunnels,
+				"extra": map[string]interface{}{
+					"pagination": map[string]interface{}{
+						"count":       len(mockSSHTunnels),
+						"total_count": len(mockSSHTunnels),
+					},
+				},
+			}
+			return http.StatusOK, response, nil
+		},
+	}
+
+	mockServer := testhelpers.SetupMockServer(t, handlers)
+	defer mockServer.Close()
+
+	config := fmt.Sprintf(`
+		provider "dbtcloud" {
+		host_url   = "%s"
+		token      = "test-token"
+		account_id = 123
+		}
+
+		data "dbtcloud_ssh_tunnels" "test" {}
+
+		output "public_keys_by_connection" {
+		  value = {
+		    for tunnel in data.dbtcloud_ssh_tunnels.test.ssh_tunnels :
+		    tostring(tunnel.connection_id) => tunnel.public_key
+		  }
+		}
+		`, mockServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.0.id", "1001"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.0.connection_id", "10"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.0.hostname", "bastion-prod.example.com"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.1.port", "2222"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.1.username", "dbt_staging"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ssh_tunnels.test", "ssh_tunnels.1.public_key", "ssh-rsa AAAAstaging dbt-cloud"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package ssh_tunnel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SSHTunnelResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ConnectionID    types.Int64  `tfsdk:"connection_id"`
+	Username        types.String `tfsdk:"username"`
+	Port            types.Int64  `tfsdk:"port"`
+	HostName        types.String `tfsdk:"hostname"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+}
+
+type SSHTunnelDataSourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	ConnectionID types.Int64  `tfsdk:"connection_id"`
+	Username     types.String `tfsdk:"username"`
+	Port         types.Int64  `tfsdk:"port"`
+	HostName     types.String `tfsdk:"hostname"`
+	PublicKey    types.String `tfsdk:"public_key"`
+}
+
+type SSHTunnelsDataSourceModel struct {
+	SSHTunnels []SSHTunnelDataSourceModel `tfsdk:"ssh_tunnels"`
+}
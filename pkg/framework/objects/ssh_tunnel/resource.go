@@ -0,0 +1,297 @@
+package ssh_tunnel
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &sshTunnelResource{}
+	_ resource.ResourceWithConfigure   = &sshTunnelResource{}
+	_ resource.ResourceWithImportState = &sshTunnelResource{}
+	_ resource.ResourceWithModifyPlan  = &sshTunnelResource{}
+)
+
+func SSHTunnelResource() resource.Resource {
+	return &sshTunnelResource{}
+}
+
+type sshTunnelResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *sshTunnelResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_tunnel"
+}
+
+func (r *sshTunnelResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+func setSSHTunnelState(state *SSHTunnelResourceModel, sshTunnel *dbt_cloud.GlobalConnectionEncryptionPayload) {
+	state.ID = types.Int64PointerValue(sshTunnel.ID)
+	state.ConnectionID = types.Int64Value(sshTunnel.ConnectionID)
+	state.Username = types.StringValue(sshTunnel.Username)
+	state.Port = types.Int64Value(sshTunnel.Port)
+	state.HostName = types.StringValue(sshTunnel.HostName)
+	state.PublicKey = types.StringValue(sshTunnel.PublicKey)
+}
+
+// rotationRequested returns true when a new value of rotation_trigger requires regenerating the key pair,
+// removing the value doesn't
+func rotationRequested(plan SSHTunnelResourceModel, state SSHTunnelResourceModel) bool {
+	return !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+// ModifyPlan plans a new ID and public key when the key pair is regenerated, as the SSH tunnel is replaced in place
+func (r *sshTunnelResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SSHTunnelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !rotationRequested(plan, state) {
+		return
+	}
+
+	plan.ID = types.Int64Unknown()
+	plan.PublicKey = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *sshTunnelResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	sshTunnel, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: plan.ConnectionID.ValueInt64(),
+		Username:     plan.Username.ValueString(),
+		Port:         plan.Port.ValueInt64(),
+		HostName:     plan.HostName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the SSH tunnel",
+			"Could not create the SSH tunnel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	setSSHTunnelState(&plan, sshTunnel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshTunnelResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnel, err := r.client.GetSSHTunnel(state.ID.ValueInt64())
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "resource-not-found") {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The SSH tunnel was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading the SSH tunnel",
+			"Could not read the SSH tunnel "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	setSSHTunnelState(&state, sshTunnel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshTunnelResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state SSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rotationRequested(plan, state) {
+		r.rotateKeyPair(ctx, plan, state, resp)
+		return
+	}
+
+	sshTunnelID := state.ID.ValueInt64()
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	sshTunnel, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: state.ConnectionID.ValueInt64(),
+		Username:     plan.Username.ValueString(),
+		Port:         plan.Port.ValueInt64(),
+		HostName:     plan.HostName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating the SSH tunnel",
+			"Could not update the SSH tunnel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	setSSHTunnelState(&plan, sshTunnel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// rotateKeyPair regenerates the key pair of the SSH tunnel. dbt Cloud only generates the key pair when an SSH tunnel is created
+// and a connection can only have one SSH tunnel, so the current one is deleted before creating the new one.
+func (r *sshTunnelResource) rotateKeyPair(
+	ctx context.Context,
+	plan SSHTunnelResourceModel,
+	state SSHTunnelResourceModel,
+	resp *resource.UpdateResponse,
+) {
+	sshTunnelID := state.ID.ValueInt64()
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	_, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: state.ConnectionID.ValueInt64(),
+		Username:     state.Username.ValueString(),
+		Port:         state.Port.ValueInt64(),
+		HostName:     state.HostName.ValueString(),
+		State:        dbt_cloud.STATE_DELETED,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error regenerating the key pair of the SSH tunnel",
+			"Could not delete the current SSH tunnel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sshTunnel, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: state.ConnectionID.ValueInt64(),
+		Username:     plan.Username.ValueString(),
+		Port:         plan.Port.ValueInt64(),
+		HostName:     plan.HostName.ValueString(),
+	})
+	if err != nil {
+		// the previous SSH tunnel doesn't exist anymore, so it is removed from the state to be created again by the next apply
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError(
+			"Error regenerating the key pair of the SSH tunnel",
+			"The current SSH tunnel was deleted but the new one could not be created, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	setSSHTunnelState(&plan, sshTunnel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshTunnelResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state SSHTunnelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnelID := state.ID.ValueInt64()
+	c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.EmptyConfig](r.client)
+	_, err := c.CreateUpdateEncryption(dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: state.ConnectionID.ValueInt64(),
+		Username:     state.Username.ValueString(),
+		Port:         state.Port.ValueInt64(),
+		HostName:     state.HostName.ValueString(),
+		State:        dbt_cloud.STATE_DELETED,
+	})
+	if err != nil && !strings.HasPrefix(err.Error(), "resource-not-found") {
+		resp.Diagnostics.AddError(
+			"Error deleting the SSH tunnel",
+			"Could not delete the SSH tunnel, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *sshTunnelResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	sshTunnelID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the SSH tunnel ID",
+			"The import ID must be the numeric ID of the SSH tunnel, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sshTunnelID)...)
+}
+
+func (r *sshTunnelResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,153 @@
+package ssh_tunnel_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudSSHTunnelResource(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	var sshTunnelID, publicKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudSSHTunnelDestroy,
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: testAccDbtCloudSSHTunnelResourceConfig(connectionName, 22, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_ssh_tunnel.test", "id"),
+					resource.TestCheckResourceAttrSet("dbtcloud_ssh_tunnel.test", "public_key"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_ssh_tunnel.test",
+						"connection_id",
+						"dbtcloud_global_connection.test",
+						"id",
+					),
+					testAccCheckDbtCloudSSHTunnelKey("dbtcloud_ssh_tunnel.test", &sshTunnelID, &publicKey, false),
+				),
+			},
+			// update in place, the key pair is kept
+			{
+				Config: testAccDbtCloudSSHTunnelResourceConfig(connectionName, 2222, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_ssh_tunnel.test", "port", "2222"),
+					testAccCheckDbtCloudSSHTunnelKey("dbtcloud_ssh_tunnel.test", &sshTunnelID, &publicKey, false),
+				),
+			},
+			// regenerate the key pair, the SSH tunnel is replaced in place
+			{
+				Config: testAccDbtCloudSSHTunnelResourceConfig(connectionName, 2222, "2026-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_ssh_tunnel.test", "rotation_trigger", "2026-01-01"),
+					testAccCheckDbtCloudSSHTunnelKey("dbtcloud_ssh_tunnel.test", &sshTunnelID, &publicKey, true),
+				),
+			},
+			// the connection doesn't manage the SSH tunnel, so the plan is empty
+			{
+				Config:   testAccDbtCloudSSHTunnelResourceConfig(connectionName, 2222, "2026-01-01"),
+				PlanOnly: true,
+			},
+			// import
+			{
+				ResourceName:            "dbtcloud_ssh_tunnel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudSSHTunnelResourceConfig(connectionName string, port int, rotationTrigger string) string {
+	rotationTriggerConfig := ""
+	if rotationTrigger != "" {
+		rotationTriggerConfig = fmt.Sprintf("rotation_trigger = %q", rotationTrigger)
+	}
+
+	return fmt.Sprintf(`
+resource "dbtcloud_global_connection" "test" {
+  name = "%s"
+
+  redshift = {
+    hostname = "test.com"
+    port     = 5439
+    dbname   = "my_database"
+  }
+}
+
+resource "dbtcloud_ssh_tunnel" "test" {
+  connection_id = dbtcloud_global_connection.test.id
+  username      = "dbt"
+  hostname      = "bastion.example.com"
+  port          = %d
+  %s
+}
+`, connectionName, port, rotationTriggerConfig)
+}
+
+// testAccCheckDbtCloudSSHTunnelKey checks whether the SSH tunnel was recreated with a new key pair since the previous step
+func testAccCheckDbtCloudSSHTunnelKey(
+	resourceName string,
+	sshTunnelID *string,
+	publicKey *string,
+	expectNewKey bool,
+) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		currentID, currentPublicKey := rs.Primary.ID, rs.Primary.Attributes["public_key"]
+
+		if *sshTunnelID != "" {
+			if expectNewKey && (currentID == *sshTunnelID || currentPublicKey == *publicKey) {
+				return fmt.Errorf("the key pair of the SSH tunnel %s was not regenerated", currentID)
+			}
+			if !expectNewKey && (currentID != *sshTunnelID || currentPublicKey != *publicKey) {
+				return fmt.Errorf("the SSH tunnel was recreated, its ID changed from %s to %s", *sshTunnelID, currentID)
+			}
+		}
+
+		*sshTunnelID, *publicKey = currentID, currentPublicKey
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudSSHTunnelDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_ssh_tunnel" {
+			continue
+		}
+		sshTunnelID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = apiClient.GetSSHTunnel(sshTunnelID)
+		if err == nil {
+			return fmt.Errorf("SSH tunnel still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package ssh_tunnel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotationRequested(t *testing.T) {
+	withTrigger := func(trigger types.String) SSHTunnelResourceModel {
+		return SSHTunnelResourceModel{RotationTrigger: trigger}
+	}
+
+	assert.False(t, rotationRequested(withTrigger(types.StringNull()), withTrigger(types.StringNull())))
+	assert.False(t, rotationRequested(withTrigger(types.StringValue("a")), withTrigger(types.StringValue("a"))))
+	assert.True(t, rotationRequested(withTrigger(types.StringValue("b")), withTrigger(types.StringValue("a"))))
+	assert.True(t, rotationRequested(withTrigger(types.StringValue("a")), withTrigger(types.StringNull())))
+	// removing the trigger keeps the key pair
+	assert.False(t, rotationRequested(withTrigger(types.StringNull()), withTrigger(types.StringValue("a"))))
+}
@@ -0,0 +1,98 @@
+package ssh_tunnel
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var resourceSchema = resource_schema.Schema{
+	Description: helper.DocString(
+		`Manage the SSH tunnel of a Redshift or PostgreSQL connection, as an alternative to the ~~~ssh_tunnel~~~ block of ~~~dbtcloud_global_connection~~~.
+
+		dbt Cloud generates a key pair when the SSH tunnel is created, its public key is returned in ~~~public_key~~~ so that it can be added to the ~~~authorized_keys~~~ of the bastion server, e.g. by another resource.
+		A connection can only have one SSH tunnel, so the ~~~ssh_tunnel~~~ block must not be set in the connection when using this resource.`,
+	),
+	Attributes: map[string]resource_schema.Attribute{
+		"id": resource_schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the SSH tunnel",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"connection_id": resource_schema.Int64Attribute{
+			Required:    true,
+			Description: "The ID of the connection to add the SSH tunnel to",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"username": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "The username to use for the SSH tunnel",
+		},
+		"port": resource_schema.Int64Attribute{
+			Required:    true,
+			Description: "The port of the bastion server for the SSH tunnel",
+		},
+		"hostname": resource_schema.StringAttribute{
+			Required:    true,
+			Description: "The hostname of the bastion server for the SSH tunnel",
+		},
+		"public_key": resource_schema.StringAttribute{
+			Computed:    true,
+			Description: "The SSH public key generated by dbt Cloud, to add to the bastion server",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rotation_trigger": resource_schema.StringAttribute{
+			Optional: true,
+			Description: "Arbitrary value that regenerates the key pair when it changes, e.g. a date or a `time_rotating` ID. " +
+				"The SSH tunnel of the connection is replaced in place by a new one with a new key pair and a new `id`, so the connection can't use the tunnel until the new public key is added to the bastion server. " +
+				"Removing the value doesn't regenerate the key pair.",
+		},
+	},
+}
+
+var datasourceSchemaAll = datasource_schema.Schema{
+	Description: "Retrieve the SSH tunnels of all the connections of the account, with their public keys.",
+	Attributes: map[string]datasource_schema.Attribute{
+		"ssh_tunnels": datasource_schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "A list of all the SSH tunnels of the account",
+			NestedObject: datasource_schema.NestedAttributeObject{
+				Attributes: map[string]datasource_schema.Attribute{
+					"id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the SSH tunnel",
+					},
+					"connection_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the connection using the SSH tunnel",
+					},
+					"username": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The username used for the SSH tunnel",
+					},
+					"port": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The port of the bastion server for the SSH tunnel",
+					},
+					"hostname": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The hostname of the bastion server for the SSH tunnel",
+					},
+					"public_key": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The SSH public key generated by dbt Cloud, to add to the bastion server",
+					},
+				},
+			},
+		},
+	},
+}
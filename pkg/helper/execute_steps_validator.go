@@ -0,0 +1,509 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+type DbtFlagKind int
+
+const (
+	// DbtFlagBool is a flag that doesn't take any value, e.g. `--full-refresh`
+	DbtFlagBool DbtFlagKind = iota
+	// DbtFlagValue is a flag that takes exactly one value, e.g. `--target prod`
+	DbtFlagValue
+	// DbtFlagMultiValue is a flag that takes one or more values, e.g. `--select model_a model_b`
+	DbtFlagMultiValue
+	// DbtFlagYAML is a flag that takes one value that must be a valid YAML string, e.g. `--vars '{key: value}'`
+	DbtFlagYAML
+)
+
+type DbtCommand struct {
+	// Flags lists the flags specific to the command, in addition to DbtGlobalFlags
+	Flags map[string]DbtFlagKind
+	// PositionalArgs is the number of arguments the command accepts that are not flags, e.g. the macro name for `run-operation`
+	PositionalArgs int
+}
+
+// DbtGlobalFlags are the flags that can be used with any dbt command
+var DbtGlobalFlags = map[string]DbtFlagKind{
+	"--debug":                         DbtFlagBool,
+	"-d":                              DbtFlagBool,
+	"--no-debug":                      DbtFlagBool,
+	"--log-level":                     DbtFlagValue,
+	"--log-level-file":                DbtFlagValue,
+	"--log-format":                    DbtFlagValue,
+	"--log-format-file":               DbtFlagValue,
+	"--use-colors":                    DbtFlagBool,
+	"--no-use-colors":                 DbtFlagBool,
+	"--quiet":                         DbtFlagBool,
+	"-q":                              DbtFlagBool,
+	"--no-quiet":                      DbtFlagBool,
+	"--print":                         DbtFlagBool,
+	"--no-print":                      DbtFlagBool,
+	"--warn-error":                    DbtFlagBool,
+	"--warn-error-options":            DbtFlagYAML,
+	"--fail-fast":                     DbtFlagBool,
+	"-x":                              DbtFlagBool,
+	"--no-fail-fast":                  DbtFlagBool,
+	"--partial-parse":                 DbtFlagBool,
+	"--no-partial-parse":              DbtFlagBool,
+	"--static-parser":                 DbtFlagBool,
+	"--no-static-parser":              DbtFlagBool,
+	"--use-experimental-parser":       DbtFlagBool,
+	"--no-use-experimental-parser":    DbtFlagBool,
+	"--cache-selected-only":           DbtFlagBool,
+	"--no-cache-selected-only":        DbtFlagBool,
+	"--introspect":                    DbtFlagBool,
+	"--no-introspect":                 DbtFlagBool,
+	"--populate-cache":                DbtFlagBool,
+	"--no-populate-cache":             DbtFlagBool,
+	"--record-timing-info":            DbtFlagValue,
+	"-r":                              DbtFlagValue,
+	"--log-cache-events":              DbtFlagBool,
+	"--no-log-cache-events":           DbtFlagBool,
+	"--printer-width":                 DbtFlagValue,
+	"--send-anonymous-usage-stats":    DbtFlagBool,
+	"--no-send-anonymous-usage-stats": DbtFlagBool,
+	"--version-check":                 DbtFlagBool,
+	"--no-version-check":              DbtFlagBool,
+	"--write-json":                    DbtFlagBool,
+	"--no-write-json":                 DbtFlagBool,
+	"--show-resource-report":          DbtFlagBool,
+	"--no-show-resource-report":       DbtFlagBool,
+	"--target":                        DbtFlagValue,
+	"-t":                              DbtFlagValue,
+	"--profile":                       DbtFlagValue,
+	"--vars":                          DbtFlagYAML,
+	"--threads":                       DbtFlagValue,
+	"--defer":                         DbtFlagBool,
+	"--no-defer":                      DbtFlagBool,
+	"--defer-state":                   DbtFlagValue,
+	"--favor-state":                   DbtFlagBool,
+	"--no-favor-state":                DbtFlagBool,
+	"--state":                         DbtFlagValue,
+	"--target-path":                   DbtFlagValue,
+	"--log-path":                      DbtFlagValue,
+	"--project-dir":                   DbtFlagValue,
+	"--profiles-dir":                  DbtFlagValue,
+	"--require-explicit-package-overrides-for-builtin-materializations": DbtFlagBool,
+}
+
+// DbtFusionOnlyFlags are the flags that are only supported by the dbt Fusion engine
+var DbtFusionOnlyFlags = map[string]DbtFlagKind{
+	"--static-analysis": DbtFlagValue,
+}
+
+var dbtSelectionFlags = map[string]DbtFlagKind{
+	"--select":                 DbtFlagMultiValue,
+	"-s":                       DbtFlagMultiValue,
+	"--models":                 DbtFlagMultiValue,
+	"-m":                       DbtFlagMultiValue,
+	"--exclude":                DbtFlagMultiValue,
+	"--selector":               DbtFlagValue,
+	"--resource-type":          DbtFlagMultiValue,
+	"--resource-types":         DbtFlagMultiValue,
+	"--exclude-resource-type":  DbtFlagMultiValue,
+	"--exclude-resource-types": DbtFlagMultiValue,
+	"--indirect-selection":     DbtFlagValue,
+}
+
+func dbtFlags(flagSets ...map[string]DbtFlagKind) map[string]DbtFlagKind {
+	flags := map[string]DbtFlagKind{}
+	for _, flagSet := range flagSets {
+		for flag, kind := range flagSet {
+			flags[flag] = kind
+		}
+	}
+	return flags
+}
+
+var (
+	dbtFullRefreshFlags = map[string]DbtFlagKind{
+		"--full-refresh":    DbtFlagBool,
+		"-f":                DbtFlagBool,
+		"--no-full-refresh": DbtFlagBool,
+	}
+	dbtEmptyFlags = map[string]DbtFlagKind{
+		"--empty":    DbtFlagBool,
+		"--no-empty": DbtFlagBool,
+	}
+	dbtMicrobatchFlags = map[string]DbtFlagKind{
+		"--event-time-start": DbtFlagValue,
+		"--event-time-end":   DbtFlagValue,
+		"--sample":           DbtFlagValue,
+		"--no-sample":        DbtFlagBool,
+	}
+	dbtStoreFailuresFlags = map[string]DbtFlagKind{
+		"--store-failures": DbtFlagBool,
+	}
+	dbtOutputFlags = map[string]DbtFlagKind{
+		"--output":      DbtFlagValue,
+		"-o":            DbtFlagValue,
+		"--output-keys": DbtFlagMultiValue,
+	}
+)
+
+// DbtCommands lists the dbt commands that can be used in job steps, along with their flags.
+// New commands can be supported by adding them to this map.
+var DbtCommands = map[string]DbtCommand{
+	"build": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtFullRefreshFlags, dbtEmptyFlags, dbtMicrobatchFlags, dbtStoreFailuresFlags, map[string]DbtFlagKind{
+			"--show": DbtFlagBool,
+		}),
+	},
+	"run": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtFullRefreshFlags, dbtEmptyFlags, dbtMicrobatchFlags),
+	},
+	"test": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtStoreFailuresFlags),
+	},
+	"seed": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtFullRefreshFlags, map[string]DbtFlagKind{
+			"--show": DbtFlagBool,
+		}),
+	},
+	"snapshot": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtEmptyFlags),
+	},
+	"source freshness": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtOutputFlags),
+	},
+	// deprecated alias of `source freshness`
+	"source snapshot-freshness": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtOutputFlags),
+	},
+	"docs generate": {
+		Flags: dbtFlags(dbtSelectionFlags, map[string]DbtFlagKind{
+			"--compile":       DbtFlagBool,
+			"--no-compile":    DbtFlagBool,
+			"--static":        DbtFlagBool,
+			"--empty-catalog": DbtFlagBool,
+		}),
+	},
+	"compile": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtFullRefreshFlags, dbtEmptyFlags, dbtOutputFlags, map[string]DbtFlagKind{
+			"--inline": DbtFlagValue,
+		}),
+	},
+	"run-operation": {
+		Flags: map[string]DbtFlagKind{
+			"--args": DbtFlagYAML,
+		},
+		PositionalArgs: 1,
+	},
+	"list": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtOutputFlags),
+	},
+	"ls": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtOutputFlags),
+	},
+	"show": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtOutputFlags, map[string]DbtFlagKind{
+			"--inline": DbtFlagValue,
+			"--limit":  DbtFlagValue,
+		}),
+	},
+	"clone": {
+		Flags: dbtFlags(dbtSelectionFlags, dbtFullRefreshFlags),
+	},
+	"parse": {},
+	"retry": {},
+	"deps":  {},
+	"debug": {},
+	"clean": {},
+	"init": {
+		PositionalArgs: 1,
+	},
+}
+
+// ExecuteStepsValidator validates that each step of a job is a known dbt command.
+// Steps not starting with `dbt` or with a typo in a known command are rejected, while unknown commands, flags and arguments
+// only raise warnings as dbt keeps adding new ones that are not listed in DbtCommands yet.
+// Flags only available in dbt Fusion are expected when `dbt_version` is `latest-fusion` or when it is not set,
+// as the job then uses the version of the environment.
+type ExecuteStepsValidator struct{}
+
+func (v ExecuteStepsValidator) Description(ctx context.Context) string {
+	return "Validates that each step is a dbt command, warning about unknown commands and flags."
+}
+
+func (v ExecuteStepsValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that each step is a `dbt` command, warning about unknown commands and flags."
+}
+
+func (v ExecuteStepsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	allowFusionFlags := true
+	var dbtVersion types.String
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("dbt_version"), &dbtVersion)
+	if !diags.HasError() && !dbtVersion.IsNull() && !dbtVersion.IsUnknown() {
+		allowFusionFlags = dbtVersion.ValueString() == "latest-fusion"
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		step, ok := element.(types.String)
+		if !ok || step.IsUnknown() || step.IsNull() {
+			continue
+		}
+
+		warnings, err := ValidateDbtCommand(step.ValueString(), allowFusionFlags)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid dbt command in execute_steps",
+				fmt.Sprintf("The step at index %d (`%s`) is invalid: %s", i, step.ValueString(), err.Error()),
+			)
+			continue
+		}
+		for _, warning := range warnings {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtListIndex(i),
+				"Unrecognized dbt argument in execute_steps",
+				fmt.Sprintf(
+					"The step at index %d (`%s`) might be invalid: %s. "+
+						"It is still accepted as it might be supported by a newer version of dbt.",
+					i,
+					step.ValueString(),
+					warning,
+				),
+			)
+		}
+	}
+}
+
+// ValidateDbtCommand checks that the step starts with `dbt` followed by a known command.
+// It returns an error when the step can't be run or when the command is a typo of a known one,
+// and warnings for the commands, flags and arguments it doesn't recognize.
+func ValidateDbtCommand(step string, allowFusionFlags bool) (warnings []string, err error) {
+	tokens, err := tokenizeDbtCommand(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 || tokens[0] != "dbt" {
+		return nil, fmt.Errorf("the step must start with `dbt`")
+	}
+	tokens = tokens[1:]
+
+	// global flags can be set before the command
+	commandIndex := 0
+	for commandIndex < len(tokens) && strings.HasPrefix(tokens[commandIndex], "-") {
+		flag, _, hasInlineValue := strings.Cut(tokens[commandIndex], "=")
+		if kind, ok := DbtGlobalFlags[flag]; ok && kind != DbtFlagBool && !hasInlineValue {
+			commandIndex++
+		}
+		commandIndex++
+	}
+	if commandIndex >= len(tokens) {
+		return nil, fmt.Errorf("no dbt command provided")
+	}
+
+	commandName := tokens[commandIndex]
+	commandEnd := commandIndex + 1
+	if commandEnd < len(tokens) {
+		if _, ok := DbtCommands[commandName+" "+tokens[commandEnd]]; ok {
+			commandName = commandName + " " + tokens[commandEnd]
+			commandEnd++
+		}
+	}
+
+	command, found := DbtCommands[commandName]
+	if !found {
+		candidates := []string{commandName}
+		if commandEnd < len(tokens) {
+			candidates = append(candidates, commandName+" "+tokens[commandEnd])
+		}
+		for _, candidate := range candidates {
+			if knownCommand, ok := closeDbtCommand(candidate); ok {
+				return nil, fmt.Errorf("unknown dbt command `%s`, did you mean `%s`?", candidate, knownCommand)
+			}
+		}
+
+		// the flags of a command we don't know can't be validated
+		return []string{fmt.Sprintf(
+			"unknown dbt command `%s`, the known commands are: %s",
+			commandName,
+			strings.Join(supportedDbtCommands(), ", "),
+		)}, nil
+	}
+
+	flags := dbtFlags(DbtGlobalFlags, command.Flags)
+	if allowFusionFlags {
+		flags = dbtFlags(flags, DbtFusionOnlyFlags)
+	}
+
+	args := append(append([]string{}, tokens[:commandIndex]...), tokens[commandEnd:]...)
+	positionalArgs := 0
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if !strings.HasPrefix(arg, "-") {
+			positionalArgs++
+			if positionalArgs > command.PositionalArgs {
+				warnings = append(warnings, fmt.Sprintf("unexpected argument `%s` for `dbt %s`", arg, commandName))
+			}
+			continue
+		}
+
+		flag, value, hasInlineValue := strings.Cut(arg, "=")
+		kind, ok := flags[flag]
+		if !ok {
+			if _, isFusionFlag := DbtFusionOnlyFlags[flag]; isFusionFlag {
+				warnings = append(warnings, fmt.Sprintf("the flag `%s` is only supported when `dbt_version` is `latest-fusion`", flag))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("unknown flag `%s` for `dbt %s`", flag, commandName))
+			}
+			// we don't know if the flag takes values, so the following arguments are considered to be its values
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+				i++
+			}
+			continue
+		}
+
+		if kind == DbtFlagBool {
+			if hasInlineValue {
+				return warnings, fmt.Errorf("the flag `%s` doesn't take a value", flag)
+			}
+			continue
+		}
+
+		values := []string{}
+		if hasInlineValue {
+			values = append(values, value)
+		}
+		for (kind == DbtFlagMultiValue || len(values) == 0) &&
+			i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			values = append(values, args[i+1])
+			i++
+		}
+		if len(values) == 0 || values[0] == "" {
+			return warnings, fmt.Errorf("the flag `%s` requires a value", flag)
+		}
+
+		if kind == DbtFlagYAML {
+			var parsed any
+			if err := yaml.Unmarshal([]byte(values[0]), &parsed); err != nil {
+				return warnings, fmt.Errorf("the value of `%s` is not valid YAML: %s", flag, err.Error())
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+func supportedDbtCommands() []string {
+	commands := []string{}
+	for command := range DbtCommands {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// closeDbtCommand returns the known command that the given one is most likely a typo of.
+// Short names are ignored as most other short names are only a few edits away from them.
+func closeDbtCommand(name string) (string, bool) {
+	if len(name) < 4 {
+		return "", false
+	}
+	maxDistance := 1
+	if len(name) >= 8 {
+		maxDistance = 2
+	}
+
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, command := range supportedDbtCommands() {
+		if distance := editDistance(name, command); distance < closestDistance {
+			closest = command
+			closestDistance = distance
+		}
+	}
+	return closest, closest != ""
+}
+
+// editDistance returns the number of insertions, deletions, substitutions and transpositions of adjacent characters
+// needed to change a into b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	distances := make([][]int, len(ra)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(rb)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			distances[i][j] = min(distances[i-1][j]+1, distances[i][j-1]+1, distances[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+	return distances[len(ra)][len(rb)]
+}
+
+// tokenizeDbtCommand splits the command like a shell would, handling single quotes, double quotes and escaped characters
+func tokenizeDbtCommand(step string) ([]string, error) {
+	tokens := []string{}
+	var current strings.Builder
+	inToken := false
+	var quote rune
+
+	runes := []rune(step)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else if r == '\\' && quote == '"' && i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inToken = true
+		case r == '\\' && i+1 < len(runes):
+			i++
+			current.WriteRune(runes[i])
+			inToken = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %c", quote)
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"testing"
+)
+
+func TestValidateDbtCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		step             string
+		allowFusionFlags bool
+		expectError      bool
+		expectWarning    bool
+	}{
+		{name: "simple build", step: "dbt build"},
+		{name: "build with selection", step: "dbt build -s state:modified+ --fail-fast"},
+		{name: "multiple selected models", step: "dbt run --select model_a model_b --exclude model_c"},
+		{name: "inline flag value", step: "dbt run --select=model_a --threads=8"},
+		{name: "global flags before the command", step: "dbt --target prod --debug run"},
+		{name: "two words command", step: "dbt source freshness --select source:raw"},
+		{name: "docs generate", step: "dbt docs generate --no-compile"},
+		{name: "valid vars", step: `dbt run --vars '{"key": "value", "other": 1}'`},
+		{name: "run-operation with args", step: `dbt run-operation my_macro --args "{table: my_table}"`},
+		{name: "fusion flag allowed", step: "dbt build --static-analysis off", allowFusionFlags: true},
+		{name: "new flag with a value", step: "dbt build --new-flag some_value --select my_model", expectWarning: true},
+		{name: "typo in the command", step: "dbt biuld", expectError: true},
+		{name: "typo in a two words command", step: "dbt source freshnes", expectError: true},
+		{name: "unknown command", step: "dbt sl list --metrics", expectWarning: true},
+		{name: "deprecated source freshness alias", step: "dbt source snapshot-freshness --select source:raw"},
+		{name: "clean", step: "dbt clean"},
+		{name: "missing dbt prefix", step: "build -s my_model", expectError: true},
+		{name: "no command", step: "dbt --debug", expectError: true},
+		{name: "unknown flag", step: "dbt run --full-refrsh", expectWarning: true},
+		{name: "flag not supported by the command", step: "dbt test --full-refresh", expectWarning: true},
+		{name: "positional argument", step: "dbt build +my_model", expectWarning: true},
+		{name: "missing flag value", step: "dbt run --select", expectError: true},
+		{name: "value for a boolean flag", step: "dbt run --fail-fast=true", expectError: true},
+		{name: "invalid vars", step: `dbt run --vars '{key: [value'`, expectError: true},
+		{name: "run-operation with two macros", step: "dbt run-operation macro_a macro_b", expectWarning: true},
+		{name: "unterminated quote", step: `dbt run --vars '{key: value}`, expectError: true},
+		{name: "fusion flag not allowed", step: "dbt build --static-analysis off", expectWarning: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := ValidateDbtCommand(tc.step, tc.allowFusionFlags)
+			if tc.expectError && err == nil {
+				t.Errorf("expected an error for `%s`, got none", tc.step)
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("expected no error for `%s`, got: %s", tc.step, err)
+			}
+			if tc.expectWarning && len(warnings) == 0 {
+				t.Errorf("expected a warning for `%s`, got none", tc.step)
+			}
+			if !tc.expectWarning && len(warnings) > 0 {
+				t.Errorf("expected no warning for `%s`, got: %v", tc.step, warnings)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b     string
+		distance int
+	}{
+		{a: "build", b: "build", distance: 0},
+		{a: "biuld", b: "build", distance: 1},
+		{a: "buil", b: "build", distance: 1},
+		{a: "snapshto", b: "snapshot", distance: 1},
+		{a: "run", b: "test", distance: 4},
+	}
+
+	for _, tc := range testCases {
+		if distance := editDistance(tc.a, tc.b); distance != tc.distance {
+			t.Errorf("expected a distance of %d between `%s` and `%s`, got %d", tc.distance, tc.a, tc.b, distance)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// importedPrivateKey is the private state key set when a resource is imported.
+// It lets the Read following the import fill the attributes that are otherwise only refreshed when managed in the config.
+const importedPrivateKey = "imported"
+
+// PrivateStateGetter is implemented by the private state of the framework requests
+type PrivateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// PrivateStateSetter is implemented by the private state of the framework responses
+type PrivateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// SetImported records in the private state that the resource is being imported
+func SetImported(ctx context.Context, private PrivateStateSetter) diag.Diagnostics {
+	return private.SetKey(ctx, importedPrivateKey, []byte("true"))
+}
+
+// IsImported returns true when the resource was imported and not read since
+func IsImported(ctx context.Context, private PrivateStateGetter) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, importedPrivateKey)
+	return string(value) == "true", diags
+}
+
+// ClearImported removes the import marker from the private state, once the imported resource has been read
+func ClearImported(ctx context.Context, private PrivateStateSetter) diag.Diagnostics {
+	return private.SetKey(ctx, importedPrivateKey, nil)
+}
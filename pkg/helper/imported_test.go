@@ -0,0 +1,41 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+func TestImported(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	imported, _ := IsImported(ctx, private)
+	assert.False(t, imported)
+
+	SetImported(ctx, private)
+	imported, _ = IsImported(ctx, private)
+	assert.True(t, imported)
+
+	ClearImported(ctx, private)
+	imported, _ = IsImported(ctx, private)
+	assert.False(t, imported)
+	assert.Empty(t, private)
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// SecretEnvironmentVariablePrefix is the prefix of the environment variables whose values are masked by dbt Cloud
+const SecretEnvironmentVariablePrefix = "DBT_ENV_SECRET_"
+
+const secretHashSeparator = "$"
+
+// IsSecretEnvironmentVariable returns true when the values of the environment variable are masked by dbt Cloud
+func IsSecretEnvironmentVariable(name string) bool {
+	return strings.HasPrefix(name, SecretEnvironmentVariablePrefix)
+}
+
+// HashSecret returns a salted hash of the secret, in the format salt$hash.
+// It is stored in the state instead of the secret to detect when the secret is changed in the config.
+func HashSecret(secret string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	saltHex := hex.EncodeToString(salt)
+	return saltHex + secretHashSeparator + hashSecretWithSalt(saltHex, secret), nil
+}
+
+// SecretMatchesHash returns true when the secret is the one the hash was generated from with HashSecret
+func SecretMatchesHash(secret string, hash string) bool {
+	salt, expected, found := strings.Cut(hash, secretHashSeparator)
+	if !found {
+		return false
+	}
+	actual := hashSecretWithSalt(salt, secret)
+	return subtle.ConstantTimeCompare([]byte(actual), []byte(expected)) == 1
+}
+
+func hashSecretWithSalt(salt string, secret string) string {
+	hash := sha256.Sum256([]byte(salt + secret))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,30 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSecretEnvironmentVariable(t *testing.T) {
+	assert.True(t, IsSecretEnvironmentVariable("DBT_ENV_SECRET_TOKEN"))
+	assert.False(t, IsSecretEnvironmentVariable("DBT_TOKEN"))
+	assert.False(t, IsSecretEnvironmentVariable("DBT_ENV_SECRET"))
+}
+
+func TestHashSecret(t *testing.T) {
+	hash, err := HashSecret("my-secret")
+	assert.NoError(t, err)
+	assert.NotContains(t, hash, "my-secret")
+	assert.True(t, SecretMatchesHash("my-secret", hash))
+	assert.False(t, SecretMatchesHash("other-secret", hash))
+
+	// the hash is salted, so hashing the same secret twice gives different results
+	otherHash, err := HashSecret("my-secret")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+	assert.True(t, SecretMatchesHash("my-secret", otherHash))
+
+	assert.False(t, SecretMatchesHash("my-secret", ""))
+	assert.False(t, SecretMatchesHash("my-secret", "not-a-hash"))
+}
@@ -22,13 +22,20 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/account_features"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/apache_spark_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/athena_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/azure_dev_ops_project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/azure_dev_ops_repository"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/bigquery_credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/connection_test_run"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/databricks_credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/dbt_version"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/fabric_credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/github_installation"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/github_repository"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/gitlab_project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group_partial_permissions"
@@ -44,12 +51,14 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/postgres_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project_artefacts"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project_dependency"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project_repository"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/redshift_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/repository"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/scim_group_permissions"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/semantic_layer_credential_service_token_mapping"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/ssh_tunnel"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/starburst_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/webhook"
@@ -116,6 +125,10 @@ func (p *dbtCloudProvider) Schema(
 				Optional:    true,
 				Description: "If set to true, the provider will not retry requests that fail due to rate limiting. Defaults to false.",
 			},
+			"disable_deprecation_warnings": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, the provider will not warn when resources use deprecated values, like environments pinned to a deprecated dbt version. Defaults to false.",
+			},
 			"retriable_status_codes": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -134,13 +147,14 @@ func (p *dbtCloudProvider) Schema(
 }
 
 type dbtCloudProviderModel struct {
-	Token                types.String `tfsdk:"token"`
-	AccountID            types.Int64  `tfsdk:"account_id"`
-	HostURL              types.String `tfsdk:"host_url"`
-	MaxRetries           types.Int64  `tfsdk:"max_retries"`
-	RetryIntervalSeconds types.Int64  `tfsdk:"retry_interval_seconds"`
-	DisableRetry         types.Bool   `tfsdk:"disable_retry"`
-	RetriableStatusCodes types.List   `tfsdk:"retriable_status_codes"`
+	Token                      types.String `tfsdk:"token"`
+	AccountID                  types.Int64  `tfsdk:"account_id"`
+	HostURL                    types.String `tfsdk:"host_url"`
+	MaxRetries                 types.Int64  `tfsdk:"max_retries"`
+	RetryIntervalSeconds       types.Int64  `tfsdk:"retry_interval_seconds"`
+	DisableRetry               types.Bool   `tfsdk:"disable_retry"`
+	RetriableStatusCodes       types.List   `tfsdk:"retriable_status_codes"`
+	DisableDeprecationWarnings types.Bool   `tfsdk:"disable_deprecation_warnings"`
 }
 
 func (p *dbtCloudProvider) Configure(
@@ -267,6 +281,7 @@ func (p *dbtCloudProvider) Configure(
 		)
 		return
 	}
+	client.DisableDeprecationWarnings = config.DisableDeprecationWarnings.ValueBool()
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -276,21 +291,29 @@ func (p *dbtCloudProvider) Configure(
 
 func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		apache_spark_credential.ApacheSparkCredentialDataSource,
 		athena_credential.NewAthenaCredentialDataSource,
 		azure_dev_ops_project.AzureDevOpsProjectDataSource,
 		azure_dev_ops_repository.AzureDevOpsRepositoryDataSource,
+		connection_test_run.ConnectionTestDataSource,
+		dbt_version.DbtVersionsDataSource,
 		environment.EnvironmentDataSource,
 		environment.EnvironmentsDataSource,
+		github_installation.GitHubInstallationDataSource,
+		github_repository.GitHubRepositoryDataSource,
+		gitlab_project.GitLabProjectDataSource,
 		global_connection.GlobalConnectionDataSource,
 		global_connection.GlobalConnectionsDataSource,
 		group.GroupDataSource,
 		group.GroupsDataSource,
 		job.JobDataSource,
+		job.JobGraphDataSource,
 		job.JobsDataSource,
 		model_notifications.ModelNotificationsDataSource,
 		notification.NotificationDataSource,
 		project.ProjectsDataSource,
 		repository.RepositoryDataSource,
+		repository.RepositoriesDataSource,
 		service_token.ServiceTokenDataSource,
 		starburst_credential.StarburstCredentialDataSource,
 		user.UserDataSource,
@@ -305,19 +328,24 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		extended_attributes.ExtendedAttributesDataSource,
 		teradata_credential.TeradataCredentialDataSource,
 		environment_variable.EnvironmentVariableDataSource,
+		environment_variable.EnvironmentVariablesDataSource,
 		project.ProjectDataSource,
+		project_dependency.ProjectDependenciesDataSource,
 		privatelink_endpoint.PrivatelinkEndpointDataSource,
 		privatelink_endpoint.PrivatelinkEndpointDataSourceAll,
 		group_users.GroupUsersDataSource,
 		runs.RunsDataSource,
 		synapse_credential.SynapseCredentialDataSource,
+		ssh_tunnel.SSHTunnelDataSourceAll,
 	}
 }
 
 func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		account_features.AccountFeaturesResource,
+		apache_spark_credential.ApacheSparkCredentialResource,
 		athena_credential.NewAthenaCredentialResource,
+		credential.CredentialResource,
 		global_connection.GlobalConnectionResource,
 		group_partial_permissions.GroupPartialPermissionsResource,
 		group.GroupResource,
@@ -330,10 +358,12 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		partial_environment_variable.PartialEnvironmentVariableResource,
 		partial_license_map.PartialLicenseMapResource,
 		partial_notification.PartialNotificationResource,
+		privatelink_endpoint.PrivatelinkEndpointResource,
 		project_artefacts.ProjectArtefactsResource,
 		repository.RepositoryResource,
 		scim_group_permissions.ScimGroupPermissionsResource,
 		service_token.ServiceTokenResource,
+		ssh_tunnel.SSHTunnelResource,
 		starburst_credential.StarburstCredentialResource,
 		bigquery_credential.BigqueryCredentialResource,
 		redshift_credential.RedshiftCredentialResource,
@@ -343,12 +373,17 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		webhook.WebhookResource,
 		databricks_credential.DatabricksCredentialResource,
 		environment.EnvironmentResource,
+		environment.EnvironmentCloneResource,
 		snowflake_credential.SnowflakeCredentialResource,
+		snowflake_credential.SnowflakeCredentialKeyRotationResource,
 		extended_attributes.ExtendedAttributesResource,
 		teradata_credential.TeradataCredentialResource,
 		job.JobResource,
+		job.JobCopyResource,
 		project_repository.ProjectRepositoryResource,
+		project_dependency.ProjectDependencyResource,
 		environment_variable.EnvironmentVariableResource,
+		environment_variable.EnvironmentVariablesResource,
 		environment_variable_job_override.EnvironmentVariableJobOverrideResource,
 		project.ProjectResource,
 		semantic_layer_configuration.SemanticLayerConfigurationResource,